@@ -1,5 +1,7 @@
 package builder
 
+import "github.com/networkteam/qrb/types"
+
 type alterActionKind int
 
 const (
@@ -51,23 +53,23 @@ func (b AlterTableBuilder) IfExists() AlterTableBuilder {
 }
 
 // AddColumn adds an ADD COLUMN action.
-func (b AlterTableBuilder) AddColumn(name string, typeName string) AddColumnAlterTableBuilder {
+func (b AlterTableBuilder) AddColumn(name string, typeName types.Type) AddColumnAlterTableBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.actions, b.actions, 1)
 	newBuilder.actions = append(newBuilder.actions, alterAction{
 		kind:   alterActionAddColumn,
-		column: columnDef{name: name, typeName: typeName},
+		column: columnDef{name: name, typeName: string(typeName)},
 	})
 	return AddColumnAlterTableBuilder{AlterTableBuilder: newBuilder}
 }
 
 // AddColumnIfNotExists adds an ADD COLUMN IF NOT EXISTS action.
-func (b AlterTableBuilder) AddColumnIfNotExists(name string, typeName string) AddColumnAlterTableBuilder {
+func (b AlterTableBuilder) AddColumnIfNotExists(name string, typeName types.Type) AddColumnAlterTableBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.actions, b.actions, 1)
 	newBuilder.actions = append(newBuilder.actions, alterAction{
 		kind:        alterActionAddColumn,
-		column:      columnDef{name: name, typeName: typeName},
+		column:      columnDef{name: name, typeName: string(typeName)},
 		ifNotExists: true,
 	})
 	return AddColumnAlterTableBuilder{AlterTableBuilder: newBuilder}
@@ -467,13 +469,13 @@ type AlterColumnBuilder struct {
 }
 
 // Type adds an ALTER COLUMN ... TYPE action.
-func (b AlterColumnBuilder) Type(typeName string) AlterTableBuilder {
+func (b AlterColumnBuilder) Type(typeName types.Type) AlterTableBuilder {
 	newBuilder := b.AlterTableBuilder
 	cloneSlice(&newBuilder.actions, b.actions, 1)
 	newBuilder.actions = append(newBuilder.actions, alterAction{
 		kind:       alterActionAlterColumnType,
 		columnName: b.columnName,
-		typeName:   typeName,
+		typeName:   string(typeName),
 	})
 	return newBuilder
 }
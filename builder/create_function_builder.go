@@ -1,5 +1,7 @@
 package builder
 
+import "github.com/networkteam/qrb/types"
+
 // CreateFunction starts building a CREATE FUNCTION statement.
 func CreateFunction(functionName Identer) CreateFunctionBuilder {
 	return CreateFunctionBuilder{
@@ -43,68 +45,68 @@ func (b CreateFunctionBuilder) OrReplace() CreateFunctionBuilder {
 }
 
 // Param adds an input parameter to the function.
-func (b CreateFunctionBuilder) Param(name string, typeName string) ParamCreateFunctionBuilder {
+func (b CreateFunctionBuilder) Param(name string, typeName types.Type) ParamCreateFunctionBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.params, b.params, 1)
 	newBuilder.params = append(newBuilder.params, functionParam{
 		name:     name,
-		typeName: typeName,
+		typeName: string(typeName),
 	})
 	return ParamCreateFunctionBuilder{CreateFunctionBuilder: newBuilder}
 }
 
 // InParam adds an explicit IN parameter to the function.
-func (b CreateFunctionBuilder) InParam(name string, typeName string) ParamCreateFunctionBuilder {
+func (b CreateFunctionBuilder) InParam(name string, typeName types.Type) ParamCreateFunctionBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.params, b.params, 1)
 	newBuilder.params = append(newBuilder.params, functionParam{
 		mode:     "IN",
 		name:     name,
-		typeName: typeName,
+		typeName: string(typeName),
 	})
 	return ParamCreateFunctionBuilder{CreateFunctionBuilder: newBuilder}
 }
 
 // OutParam adds an OUT parameter to the function.
-func (b CreateFunctionBuilder) OutParam(name string, typeName string) ParamCreateFunctionBuilder {
+func (b CreateFunctionBuilder) OutParam(name string, typeName types.Type) ParamCreateFunctionBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.params, b.params, 1)
 	newBuilder.params = append(newBuilder.params, functionParam{
 		mode:     "OUT",
 		name:     name,
-		typeName: typeName,
+		typeName: string(typeName),
 	})
 	return ParamCreateFunctionBuilder{CreateFunctionBuilder: newBuilder}
 }
 
 // InOutParam adds an INOUT parameter to the function.
-func (b CreateFunctionBuilder) InOutParam(name string, typeName string) ParamCreateFunctionBuilder {
+func (b CreateFunctionBuilder) InOutParam(name string, typeName types.Type) ParamCreateFunctionBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.params, b.params, 1)
 	newBuilder.params = append(newBuilder.params, functionParam{
 		mode:     "INOUT",
 		name:     name,
-		typeName: typeName,
+		typeName: string(typeName),
 	})
 	return ParamCreateFunctionBuilder{CreateFunctionBuilder: newBuilder}
 }
 
 // VariadicParam adds a VARIADIC parameter to the function.
-func (b CreateFunctionBuilder) VariadicParam(name string, typeName string) ParamCreateFunctionBuilder {
+func (b CreateFunctionBuilder) VariadicParam(name string, typeName types.Type) ParamCreateFunctionBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.params, b.params, 1)
 	newBuilder.params = append(newBuilder.params, functionParam{
 		mode:     "VARIADIC",
 		name:     name,
-		typeName: typeName,
+		typeName: string(typeName),
 	})
 	return ParamCreateFunctionBuilder{CreateFunctionBuilder: newBuilder}
 }
 
 // Returns sets the return type of the function.
-func (b CreateFunctionBuilder) Returns(typeName string) CreateFunctionBuilder {
+func (b CreateFunctionBuilder) Returns(typeName types.Type) CreateFunctionBuilder {
 	newBuilder := b
-	newBuilder.returns = typeName
+	newBuilder.returns = string(typeName)
 	return newBuilder
 }
 
@@ -308,12 +310,12 @@ type ReturnsTableCreateFunctionBuilder struct {
 }
 
 // Column adds a column to the RETURNS TABLE clause.
-func (b ReturnsTableCreateFunctionBuilder) Column(name string, typeName string) ReturnsTableCreateFunctionBuilder {
+func (b ReturnsTableCreateFunctionBuilder) Column(name string, typeName types.Type) ReturnsTableCreateFunctionBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.returnsTable, b.returnsTable, 1)
 	newBuilder.returnsTable = append(newBuilder.returnsTable, functionReturnColumn{
 		name:     name,
-		typeName: typeName,
+		typeName: string(typeName),
 	})
 	return newBuilder
 }
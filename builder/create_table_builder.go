@@ -1,5 +1,7 @@
 package builder
 
+import "github.com/networkteam/qrb/types"
+
 // CreateTable starts building a CREATE TABLE statement.
 func CreateTable(tableName Identer) CreateTableBuilder {
 	return CreateTableBuilder{
@@ -74,12 +76,12 @@ func (b CreateTableBuilder) Like(source Identer) LikeCreateTableBuilder {
 }
 
 // Column adds a column definition to the CREATE TABLE statement.
-func (b CreateTableBuilder) Column(name string, typeName string) ColumnCreateTableBuilder {
+func (b CreateTableBuilder) Column(name string, typeName types.Type) ColumnCreateTableBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.columns, b.columns, 1)
 	newBuilder.columns = append(newBuilder.columns, columnDef{
 		name:     name,
-		typeName: typeName,
+		typeName: string(typeName),
 	})
 	return ColumnCreateTableBuilder{CreateTableBuilder: newBuilder}
 }
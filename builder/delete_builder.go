@@ -1,5 +1,7 @@
 package builder
 
+import "errors"
+
 // [ WITH [ RECURSIVE ] with_query [, ...] ]
 // DELETE FROM [ ONLY ] table_name [ * ] [ [ AS ] alias ]
 //     [ USING from_item [, ...] ]
@@ -14,15 +16,33 @@ func DeleteFrom(tableName Identer) DeleteBuilder {
 
 type DeleteBuilder struct {
 	withQueries      withQueries
+	only             bool
 	tableName        Identer
+	descendants      bool
 	alias            string
 	using            []fromItem
 	whereConjunction []Exp
-	returningItems   returningItems
+	returning        returningClause
 }
 
 func (b DeleteBuilder) isWithQuery() {}
 
+// Only adds ONLY before the table name, so only the named table is affected and not any tables inheriting from it
+// (or partitions of a partitioned table).
+func (b DeleteBuilder) Only() DeleteBuilder {
+	newBuilder := b
+	newBuilder.only = true
+	return newBuilder
+}
+
+// WithDescendants adds * after the table name to explicitly include descendant tables.
+// This is the default behavior of PostgreSQL, so it is only needed to make the intention explicit.
+func (b DeleteBuilder) WithDescendants() DeleteBuilder {
+	newBuilder := b
+	newBuilder.descendants = true
+	return newBuilder
+}
+
 func (b DeleteBuilder) As(alias string) DeleteBuilder {
 	newBuilder := b
 	newBuilder.alias = alias
@@ -79,17 +99,33 @@ func (b DeleteBuilder) Where(cond Exp) DeleteBuilder {
 	return newBuilder
 }
 
-func (b DeleteBuilder) Returning(outputExpression Exp) ReturningDeleteBuilder {
+// Returning adds the given output expressions to the RETURNING clause.
+// It can be called multiple times to add more output expressions.
+func (b DeleteBuilder) Returning(outputExpression Exp, exps ...Exp) ReturningDeleteBuilder {
 	newBuilder := b
-	newBuilder.returningItems = b.returningItems.cloneSlice(1)
-
-	newBuilder.returningItems = append(newBuilder.returningItems, returningItem{
-		outputExpression: outputExpression,
-	})
+	newBuilder.returning = b.returning.appendItems(append([]Exp{outputExpression}, exps...))
 
 	return ReturningDeleteBuilder{newBuilder}
 }
 
+// ReturningOldAs sets an alias for the OLD row in the RETURNING clause (RETURNING WITH (OLD AS alias)).
+//
+// Note: requires PostgreSQL 18 or later.
+func (b DeleteBuilder) ReturningOldAs(alias string) DeleteBuilder {
+	newBuilder := b
+	newBuilder.returning.oldAlias = alias
+	return newBuilder
+}
+
+// ReturningNewAs sets an alias for the NEW row in the RETURNING clause (RETURNING WITH (NEW AS alias)).
+//
+// Note: requires PostgreSQL 18 or later.
+func (b DeleteBuilder) ReturningNewAs(alias string) DeleteBuilder {
+	newBuilder := b
+	newBuilder.returning.newAlias = alias
+	return newBuilder
+}
+
 type ReturningDeleteBuilder struct {
 	DeleteBuilder
 }
@@ -97,10 +133,7 @@ type ReturningDeleteBuilder struct {
 // As sets the output name for the last output expression.
 func (b ReturningDeleteBuilder) As(outputName string) DeleteBuilder {
 	newBuilder := b.DeleteBuilder
-	newBuilder.returningItems = b.returningItems.cloneSlice(0)
-
-	lastIdx := len(newBuilder.returningItems) - 1
-	newBuilder.returningItems[lastIdx].outputName = outputName
+	newBuilder.returning = b.returning.setLastOutputName(outputName)
 
 	return newBuilder
 }
@@ -111,13 +144,25 @@ func (b DeleteBuilder) WriteSQL(sb *SQLBuilder) {
 	sb.WriteRune(')')
 }
 
+var ErrDeleteOnlyAndDescendants = errors.New("delete: cannot specify both ONLY and *")
+
 func (b DeleteBuilder) innerWriteSQL(sb *SQLBuilder) {
 	if len(b.withQueries) > 0 {
 		b.withQueries.WriteSQL(sb)
 	}
 
 	sb.WriteString("DELETE FROM ")
+	if b.only && b.descendants {
+		sb.AddError(ErrDeleteOnlyAndDescendants)
+		return
+	}
+	if b.only {
+		sb.WriteString("ONLY ")
+	}
 	b.tableName.WriteSQL(sb)
+	if b.descendants {
+		sb.WriteString(" *")
+	}
 	if b.alias != "" {
 		sb.WriteString(" AS ")
 		sb.WriteString(b.alias)
@@ -135,7 +180,7 @@ func (b DeleteBuilder) innerWriteSQL(sb *SQLBuilder) {
 		sb.WriteString(" WHERE ")
 		And(b.whereConjunction...).WriteSQL(sb)
 	}
-	if len(b.returningItems) > 0 {
-		b.returningItems.WriteSQL(sb)
+	if !b.returning.isEmpty() {
+		b.returning.WriteSQL(sb)
 	}
 }
@@ -2,6 +2,8 @@ package builder
 
 import (
 	"errors"
+
+	"github.com/networkteam/qrb/types"
 )
 
 // function_name ( [ argument [, ...] ] ) [ WITH ORDINALITY ]
@@ -26,7 +28,7 @@ type FuncBuilder struct {
 
 type funcColumnDefinition struct {
 	name string
-	typ  string
+	typ  types.Type
 }
 
 func (b FuncBuilder) IsExp()            {}
@@ -53,7 +55,7 @@ func (b FuncBuilder) As(alias string) FuncBuilder {
 
 // ColumnDefinition adds a column definition to the function call.
 // To add multiple column definitions, call this method multiple times.
-func (b FuncBuilder) ColumnDefinition(name, typ string) FuncBuilder {
+func (b FuncBuilder) ColumnDefinition(name string, typ types.Type) FuncBuilder {
 	newBuilder := b
 	cloneSlice(&newBuilder.columnDefs, b.columnDefs, 1)
 
@@ -101,7 +103,7 @@ func (b FuncBuilder) WriteSQL(sb *SQLBuilder) {
 			}
 			sb.WriteString(def.name)
 			sb.WriteRune(' ')
-			sb.WriteString(def.typ)
+			sb.WriteString(string(def.typ))
 		}
 		sb.WriteString(")")
 	}
@@ -23,6 +23,7 @@ type InsertBuilder struct {
 	tableName                        Identer
 	alias                            string
 	columnNames                      []string
+	overriding                       string
 	defaultValues                    bool
 	valueLists                       [][]Exp
 	query                            SelectExp
@@ -32,7 +33,7 @@ type InsertBuilder struct {
 	conflictAction                   string
 	conflictDoUpdateSetItems         []updateSetItem
 	conflictDoUpdateWhereConjunction []Exp
-	returningItems                   returningItems
+	returning                        returningClause
 }
 
 func (b InsertBuilder) isWithQuery() {}
@@ -49,6 +50,24 @@ func (b InsertBuilder) ColumnNames(columnName string, rest ...string) InsertBuil
 	return newBuilder
 }
 
+// OverridingSystemValue sets the OVERRIDING SYSTEM VALUE clause.
+// It allows to insert explicit values into identity columns that are defined as GENERATED ALWAYS.
+// It can only be used together with InsertBuilder.Values or InsertBuilder.Query.
+func (b InsertBuilder) OverridingSystemValue() InsertBuilder {
+	newBuilder := b
+	newBuilder.overriding = "SYSTEM"
+	return newBuilder
+}
+
+// OverridingUserValue sets the OVERRIDING USER VALUE clause.
+// Values supplied for identity columns are ignored and the sequence-generated values are applied.
+// It can only be used together with InsertBuilder.Values or InsertBuilder.Query.
+func (b InsertBuilder) OverridingUserValue() InsertBuilder {
+	newBuilder := b
+	newBuilder.overriding = "USER"
+	return newBuilder
+}
+
 // DefaultValues sets the DEFAULT VALUES clause to insert a row with default values.
 // If InsertBuilder.Values is called after this method, it will overrule the DEFAULT VALUES clause.
 func (b InsertBuilder) DefaultValues() InsertBuilder {
@@ -189,22 +208,33 @@ type conflictTarget struct {
 	exp Exp
 }
 
+// Returning adds the given output expressions to the RETURNING clause.
+// It can be called multiple times to add more output expressions.
 func (b InsertBuilder) Returning(outputExpression Exp, exps ...Exp) ReturningInsertBuilder {
 	newBuilder := b
-	newBuilder.returningItems = b.returningItems.cloneSlice(1 + len(exps))
-
-	newBuilder.returningItems = append(newBuilder.returningItems, returningItem{
-		outputExpression: outputExpression,
-	})
-	for _, exp := range exps {
-		newBuilder.returningItems = append(newBuilder.returningItems, returningItem{
-			outputExpression: exp,
-		})
-	}
+	newBuilder.returning = b.returning.appendItems(append([]Exp{outputExpression}, exps...))
 
 	return ReturningInsertBuilder{newBuilder}
 }
 
+// ReturningOldAs sets an alias for the OLD row in the RETURNING clause (RETURNING WITH (OLD AS alias)).
+//
+// Note: requires PostgreSQL 18 or later.
+func (b InsertBuilder) ReturningOldAs(alias string) InsertBuilder {
+	newBuilder := b
+	newBuilder.returning.oldAlias = alias
+	return newBuilder
+}
+
+// ReturningNewAs sets an alias for the NEW row in the RETURNING clause (RETURNING WITH (NEW AS alias)).
+//
+// Note: requires PostgreSQL 18 or later.
+func (b InsertBuilder) ReturningNewAs(alias string) InsertBuilder {
+	newBuilder := b
+	newBuilder.returning.newAlias = alias
+	return newBuilder
+}
+
 type ReturningInsertBuilder struct {
 	InsertBuilder
 }
@@ -212,41 +242,11 @@ type ReturningInsertBuilder struct {
 // As sets the output name for the last output expression.
 func (b ReturningInsertBuilder) As(outputName string) InsertBuilder {
 	newBuilder := b.InsertBuilder
-	newBuilder.returningItems = b.returningItems.cloneSlice(0)
-
-	lastIdx := len(newBuilder.returningItems) - 1
-	newBuilder.returningItems[lastIdx].outputName = outputName
+	newBuilder.returning = b.returning.setLastOutputName(outputName)
 
 	return newBuilder
 }
 
-type returningItem struct {
-	outputExpression Exp
-	outputName       string
-}
-
-type returningItems []returningItem
-
-func (i returningItems) WriteSQL(sb *SQLBuilder) {
-	sb.WriteString(" RETURNING ")
-	for j, item := range i {
-		if j > 0 {
-			sb.WriteString(",")
-		}
-		item.outputExpression.WriteSQL(sb)
-		if item.outputName != "" {
-			sb.WriteString(" AS ")
-			sb.WriteString(item.outputName)
-		}
-	}
-}
-
-func (i returningItems) cloneSlice(additionalCapacity int) returningItems {
-	newSlice := make(returningItems, len(i), len(i)+additionalCapacity)
-	copy(newSlice, i)
-	return newSlice
-}
-
 var ErrInsertValuesAndQuery = errors.New("insert: cannot set both values and query")
 
 // WriteSQL writes the insert as an expression.
@@ -258,6 +258,8 @@ func (b InsertBuilder) WriteSQL(sb *SQLBuilder) {
 
 var ErrInsertConflictConstraintAndTarget = errors.New("insert: cannot set both conflict constraint name and targets")
 
+var ErrInsertOverridingWithoutValuesOrQuery = errors.New("insert: OVERRIDING VALUE requires values or query")
+
 func (b InsertBuilder) innerWriteSQL(sb *SQLBuilder) {
 	if len(b.withQueries) > 0 {
 		b.withQueries.WriteSQL(sb)
@@ -286,6 +288,15 @@ func (b InsertBuilder) innerWriteSQL(sb *SQLBuilder) {
 		sb.AddError(ErrInsertValuesAndQuery)
 		return
 	}
+	if b.overriding != "" {
+		if b.valueLists == nil && b.query == nil {
+			sb.AddError(ErrInsertOverridingWithoutValuesOrQuery)
+			return
+		}
+		sb.WriteString(" OVERRIDING ")
+		sb.WriteString(b.overriding)
+		sb.WriteString(" VALUE")
+	}
 	if b.query != nil {
 		sb.WriteString(" ")
 		b.query.innerWriteSQL(sb)
@@ -379,7 +390,7 @@ func (b InsertBuilder) innerWriteSQL(sb *SQLBuilder) {
 		}
 	}
 
-	if len(b.returningItems) > 0 {
-		b.returningItems.WriteSQL(sb)
+	if !b.returning.isEmpty() {
+		b.returning.WriteSQL(sb)
 	}
 }
@@ -0,0 +1,58 @@
+package builder
+
+func JsonBuildArray(isJsonB bool) JsonBuildArrayBuilder {
+	return JsonBuildArrayBuilder{
+		isJsonB: isJsonB,
+	}
+}
+
+// JsonBuildArrayBuilder builds a json_build_array or jsonb_build_array function call with possibly heterogeneously-typed elements.
+type JsonBuildArrayBuilder struct {
+	isJsonB  bool
+	elements []Exp
+}
+
+var _ Exp = JsonBuildArrayBuilder{}
+
+func (b JsonBuildArrayBuilder) IsExp() {}
+
+func (b JsonBuildArrayBuilder) WriteSQL(sb *SQLBuilder) {
+	if b.isJsonB {
+		sb.WriteString("jsonb_build_array(")
+	} else {
+		sb.WriteString("json_build_array(")
+	}
+
+	for i, element := range b.elements {
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		element.WriteSQL(sb)
+	}
+
+	sb.WriteRune(')')
+}
+
+// Append adds elements to the end of the array.
+func (b JsonBuildArrayBuilder) Append(exps ...Exp) JsonBuildArrayBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.elements, b.elements, len(exps))
+
+	newBuilder.elements = append(newBuilder.elements, exps...)
+	return newBuilder
+}
+
+// AppendIf adds elements to the end of the array if the condition is true.
+func (b JsonBuildArrayBuilder) AppendIf(condition bool, exps ...Exp) JsonBuildArrayBuilder {
+	if condition {
+		return b.Append(exps...)
+	}
+	return b
+}
+
+func (b JsonBuildArrayBuilder) ApplyIf(condition bool, apply func(b JsonBuildArrayBuilder) JsonBuildArrayBuilder) JsonBuildArrayBuilder {
+	if condition {
+		return apply(b)
+	}
+	return b
+}
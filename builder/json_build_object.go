@@ -1,5 +1,14 @@
 package builder
 
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/networkteam/qrb/types"
+)
+
 func JsonBuildObject(isJsonB bool) JsonBuildObjectBuilder {
 	return JsonBuildObjectBuilder{
 		isJsonB: isJsonB,
@@ -95,3 +104,836 @@ func (bb *JsonBuildObjectBuilderBuilder) PropIf(condition bool, key string, valu
 	}
 	return bb
 }
+
+// --- SQL/JSON functions
+
+// See https://www.postgresql.org/docs/current/functions-json.html#FUNCTIONS-JSON-CREATION-TABLE
+// and https://www.postgresql.org/docs/current/functions-json.html#SQLJSON-QUERY-FUNCTIONS.
+
+type jsonOnNull string
+
+const (
+	jsonNullOnNull   jsonOnNull = "NULL ON NULL"
+	jsonAbsentOnNull jsonOnNull = "ABSENT ON NULL"
+)
+
+type jsonUniqueKeys string
+
+const (
+	jsonWithUniqueKeys    jsonUniqueKeys = "WITH UNIQUE KEYS"
+	jsonWithoutUniqueKeys jsonUniqueKeys = "WITHOUT UNIQUE KEYS"
+)
+
+// jsonConstructorOptions are the common trailing options of the SQL/JSON constructor functions.
+type jsonConstructorOptions struct {
+	onNull     jsonOnNull
+	uniqueKeys jsonUniqueKeys
+	returning  types.Type
+}
+
+func (o jsonConstructorOptions) WriteSQL(sb *SQLBuilder) {
+	if o.onNull != "" {
+		sb.WriteRune(' ')
+		sb.WriteString(string(o.onNull))
+	}
+	if o.uniqueKeys != "" {
+		sb.WriteRune(' ')
+		sb.WriteString(string(o.uniqueKeys))
+	}
+	writeJsonReturning(sb, o.returning)
+}
+
+func writeJsonReturning(sb *SQLBuilder, returning types.Type) {
+	if returning != "" {
+		sb.WriteString(" RETURNING ")
+		expType(returning).WriteSQL(sb)
+	}
+}
+
+// JsonObject builds a JSON_OBJECT constructor.
+// Properties are added with JsonObjectBuilder.Prop.
+//
+// Example:
+//
+//	JsonObject().Prop("name", N("name")).Prop("email", N("email")).AbsentOnNull().Returning("jsonb")
+//	// JSON_OBJECT('name' VALUE name,'email' VALUE email ABSENT ON NULL RETURNING jsonb)
+func JsonObject() JsonObjectBuilder {
+	b := JsonObjectBuilder{
+		props: newImmutableSliceMap[string, Exp](),
+	}
+	b.Exp = b // self-reference for base methods
+	return b
+}
+
+// JsonObjectBuilder builds a JSON_OBJECT constructor.
+type JsonObjectBuilder struct {
+	ExpBase
+	props   immutableSliceMap[string, Exp]
+	options jsonConstructorOptions
+}
+
+func (b JsonObjectBuilder) IsExp() {}
+
+// Prop sets the property with the given key to the value.
+func (b JsonObjectBuilder) Prop(key string, value Exp) JsonObjectBuilder {
+	newBuilder := b
+	newBuilder.props = b.props.Set(key, value)
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// PropIf sets the property with the given key to the value if the condition is true.
+func (b JsonObjectBuilder) PropIf(condition bool, key string, value Exp) JsonObjectBuilder {
+	if condition {
+		return b.Prop(key, value)
+	}
+	return b
+}
+
+// NullOnNull keeps properties with null values (the default).
+func (b JsonObjectBuilder) NullOnNull() JsonObjectBuilder {
+	return b.setOnNull(jsonNullOnNull)
+}
+
+// AbsentOnNull omits properties with null values.
+func (b JsonObjectBuilder) AbsentOnNull() JsonObjectBuilder {
+	return b.setOnNull(jsonAbsentOnNull)
+}
+
+func (b JsonObjectBuilder) setOnNull(onNull jsonOnNull) JsonObjectBuilder {
+	newBuilder := b
+	newBuilder.options.onNull = onNull
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// WithUniqueKeys raises an error for duplicate keys.
+func (b JsonObjectBuilder) WithUniqueKeys() JsonObjectBuilder {
+	return b.setUniqueKeys(jsonWithUniqueKeys)
+}
+
+// WithoutUniqueKeys allows duplicate keys (the default).
+func (b JsonObjectBuilder) WithoutUniqueKeys() JsonObjectBuilder {
+	return b.setUniqueKeys(jsonWithoutUniqueKeys)
+}
+
+func (b JsonObjectBuilder) setUniqueKeys(uniqueKeys jsonUniqueKeys) JsonObjectBuilder {
+	newBuilder := b
+	newBuilder.options.uniqueKeys = uniqueKeys
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// Returning sets the type of the result (defaults to json).
+func (b JsonObjectBuilder) Returning(typ types.Type) JsonObjectBuilder {
+	newBuilder := b
+	newBuilder.options.returning = typ
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+func (b JsonObjectBuilder) WriteSQL(sb *SQLBuilder) {
+	sb.WriteString("JSON_OBJECT(")
+	i := 0
+	for _, entry := range b.props {
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		sb.WriteString(pqQuoteLiteral(entry.k))
+		sb.WriteString(" VALUE ")
+		entry.v.WriteSQL(sb)
+
+		i++
+	}
+	b.options.WriteSQL(sb)
+	sb.WriteRune(')')
+}
+
+// JsonArray builds a JSON_ARRAY constructor of the given values.
+//
+// Example:
+//
+//	JsonArray(N("a"), N("b")).AbsentOnNull()
+//	// JSON_ARRAY(a,b ABSENT ON NULL)
+func JsonArray(exps ...Exp) JsonArrayBuilder {
+	b := JsonArrayBuilder{
+		exps: exps,
+	}
+	b.Exp = b // self-reference for base methods
+	return b
+}
+
+// JsonArrayQuery builds a JSON_ARRAY constructor of the rows of a query that returns a single column.
+func JsonArrayQuery(query SelectExp) JsonArrayBuilder {
+	b := JsonArrayBuilder{
+		query: query,
+	}
+	b.Exp = b // self-reference for base methods
+	return b
+}
+
+// JsonArrayBuilder builds a JSON_ARRAY constructor.
+type JsonArrayBuilder struct {
+	ExpBase
+	exps    []Exp
+	query   SelectExp
+	options jsonConstructorOptions
+}
+
+func (b JsonArrayBuilder) IsExp() {}
+
+// Append adds values to the end of the array.
+func (b JsonArrayBuilder) Append(exps ...Exp) JsonArrayBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.exps, b.exps, len(exps))
+
+	newBuilder.exps = append(newBuilder.exps, exps...)
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// AppendIf adds values to the end of the array if the condition is true.
+func (b JsonArrayBuilder) AppendIf(condition bool, exps ...Exp) JsonArrayBuilder {
+	if condition {
+		return b.Append(exps...)
+	}
+	return b
+}
+
+// NullOnNull keeps null values as JSON nulls.
+func (b JsonArrayBuilder) NullOnNull() JsonArrayBuilder {
+	return b.setOnNull(jsonNullOnNull)
+}
+
+// AbsentOnNull omits null values (the default).
+func (b JsonArrayBuilder) AbsentOnNull() JsonArrayBuilder {
+	return b.setOnNull(jsonAbsentOnNull)
+}
+
+func (b JsonArrayBuilder) setOnNull(onNull jsonOnNull) JsonArrayBuilder {
+	newBuilder := b
+	newBuilder.options.onNull = onNull
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// Returning sets the type of the result (defaults to json).
+func (b JsonArrayBuilder) Returning(typ types.Type) JsonArrayBuilder {
+	newBuilder := b
+	newBuilder.options.returning = typ
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+var ErrJsonArrayQueryWithValues = errors.New("json array: cannot use query together with values")
+var ErrJsonArrayQueryOnNull = errors.New("json array: NULL ON NULL / ABSENT ON NULL cannot be used with a query")
+
+func (b JsonArrayBuilder) WriteSQL(sb *SQLBuilder) {
+	if b.query != nil {
+		if len(b.exps) > 0 {
+			sb.AddError(ErrJsonArrayQueryWithValues)
+			return
+		}
+		if b.options.onNull != "" {
+			sb.AddError(ErrJsonArrayQueryOnNull)
+			return
+		}
+	}
+
+	sb.WriteString("JSON_ARRAY(")
+	if b.query != nil {
+		b.query.innerWriteSQL(sb)
+	}
+	for i, exp := range b.exps {
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		exp.WriteSQL(sb)
+	}
+	b.options.WriteSQL(sb)
+	sb.WriteRune(')')
+}
+
+// JsonArrayAgg builds the JSON_ARRAYAGG aggregate function.
+//
+// Example:
+//
+//	JsonArrayAgg(N("name")).OrderBy(N("name")).Desc().AbsentOnNull().Returning("jsonb")
+//	// JSON_ARRAYAGG(name ORDER BY name DESC ABSENT ON NULL RETURNING jsonb)
+func JsonArrayAgg(exp Exp) JsonArrayAggBuilder {
+	b := JsonArrayAggBuilder{
+		exp: exp,
+	}
+	b.Exp = b // self-reference for base methods
+	return b
+}
+
+// JsonArrayAggBuilder builds the JSON_ARRAYAGG aggregate function.
+type JsonArrayAggBuilder struct {
+	ExpBase
+	exp               Exp
+	orderBys          []orderByClause
+	options           jsonConstructorOptions
+	filterConjunction []Exp
+}
+
+// OrderByJsonArrayAggBuilder allows to set the sort order of the last ORDER BY expression.
+type OrderByJsonArrayAggBuilder struct {
+	JsonArrayAggBuilder
+}
+
+func (b JsonArrayAggBuilder) IsExp() {}
+
+// OrderBy adds an ORDER BY expression to sort the array elements.
+func (b JsonArrayAggBuilder) OrderBy(exp Exp) OrderByJsonArrayAggBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.orderBys, b.orderBys, 1)
+
+	newBuilder.orderBys = append(newBuilder.orderBys, orderByClause{
+		exp: exp,
+	})
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return OrderByJsonArrayAggBuilder{
+		JsonArrayAggBuilder: newBuilder,
+	}
+}
+
+func (b OrderByJsonArrayAggBuilder) Asc() OrderByJsonArrayAggBuilder {
+	return b.setOrder(sortOrderAsc)
+}
+
+func (b OrderByJsonArrayAggBuilder) Desc() OrderByJsonArrayAggBuilder {
+	return b.setOrder(sortOrderDesc)
+}
+
+func (b OrderByJsonArrayAggBuilder) setOrder(order sortOrder) OrderByJsonArrayAggBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.orderBys, b.orderBys, 0)
+
+	newBuilder.orderBys[len(newBuilder.orderBys)-1].order = order
+
+	newBuilder.Exp = newBuilder.JsonArrayAggBuilder // self-reference for base methods
+	return newBuilder
+}
+
+func (b OrderByJsonArrayAggBuilder) NullsFirst() OrderByJsonArrayAggBuilder {
+	return b.setNulls(sortNullsFirst)
+}
+
+func (b OrderByJsonArrayAggBuilder) NullsLast() OrderByJsonArrayAggBuilder {
+	return b.setNulls(sortNullsLast)
+}
+
+func (b OrderByJsonArrayAggBuilder) setNulls(nulls sortNulls) OrderByJsonArrayAggBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.orderBys, b.orderBys, 0)
+
+	newBuilder.orderBys[len(newBuilder.orderBys)-1].nulls = nulls
+
+	newBuilder.Exp = newBuilder.JsonArrayAggBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// NullOnNull keeps null values as JSON nulls.
+func (b JsonArrayAggBuilder) NullOnNull() JsonArrayAggBuilder {
+	return b.setOnNull(jsonNullOnNull)
+}
+
+// AbsentOnNull omits null values (the default).
+func (b JsonArrayAggBuilder) AbsentOnNull() JsonArrayAggBuilder {
+	return b.setOnNull(jsonAbsentOnNull)
+}
+
+func (b JsonArrayAggBuilder) setOnNull(onNull jsonOnNull) JsonArrayAggBuilder {
+	newBuilder := b
+	newBuilder.options.onNull = onNull
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// Returning sets the type of the result (defaults to json).
+func (b JsonArrayAggBuilder) Returning(typ types.Type) JsonArrayAggBuilder {
+	newBuilder := b
+	newBuilder.options.returning = typ
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// Filter adds a filter to the aggregate function.
+// Multiple calls to Filter are joined with AND.
+func (b JsonArrayAggBuilder) Filter(cond Exp) JsonArrayAggBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.filterConjunction, b.filterConjunction, 1)
+
+	newBuilder.filterConjunction = append(newBuilder.filterConjunction, cond)
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+func (b JsonArrayAggBuilder) WriteSQL(sb *SQLBuilder) {
+	sb.WriteString("JSON_ARRAYAGG(")
+	b.exp.WriteSQL(sb)
+	if len(b.orderBys) > 0 {
+		sb.WriteString(" ORDER BY ")
+		for i, clause := range b.orderBys {
+			if i > 0 {
+				sb.WriteRune(',')
+			}
+			clause.WriteSQL(sb)
+		}
+	}
+	b.options.WriteSQL(sb)
+	sb.WriteRune(')')
+
+	writeFilter(sb, b.filterConjunction)
+}
+
+func writeFilter(sb *SQLBuilder, filterConjunction []Exp) {
+	if len(filterConjunction) > 0 {
+		sb.WriteString(" FILTER (WHERE ")
+		And(filterConjunction...).WriteSQL(sb)
+		sb.WriteRune(')')
+	}
+}
+
+// JsonObjectAgg builds the JSON_OBJECTAGG aggregate function of key / value pairs.
+//
+// Example:
+//
+//	JsonObjectAgg(N("k"), N("v")).AbsentOnNull().WithUniqueKeys()
+//	// JSON_OBJECTAGG(k VALUE v ABSENT ON NULL WITH UNIQUE KEYS)
+func JsonObjectAgg(key, value Exp) JsonObjectAggBuilder {
+	b := JsonObjectAggBuilder{
+		key:   key,
+		value: value,
+	}
+	b.Exp = b // self-reference for base methods
+	return b
+}
+
+// JsonObjectAggBuilder builds the JSON_OBJECTAGG aggregate function.
+type JsonObjectAggBuilder struct {
+	ExpBase
+	key               Exp
+	value             Exp
+	options           jsonConstructorOptions
+	filterConjunction []Exp
+}
+
+func (b JsonObjectAggBuilder) IsExp() {}
+
+// NullOnNull keeps properties with null values (the default).
+func (b JsonObjectAggBuilder) NullOnNull() JsonObjectAggBuilder {
+	return b.setOnNull(jsonNullOnNull)
+}
+
+// AbsentOnNull omits properties with null values.
+func (b JsonObjectAggBuilder) AbsentOnNull() JsonObjectAggBuilder {
+	return b.setOnNull(jsonAbsentOnNull)
+}
+
+func (b JsonObjectAggBuilder) setOnNull(onNull jsonOnNull) JsonObjectAggBuilder {
+	newBuilder := b
+	newBuilder.options.onNull = onNull
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// WithUniqueKeys raises an error for duplicate keys.
+func (b JsonObjectAggBuilder) WithUniqueKeys() JsonObjectAggBuilder {
+	return b.setUniqueKeys(jsonWithUniqueKeys)
+}
+
+// WithoutUniqueKeys allows duplicate keys (the default).
+func (b JsonObjectAggBuilder) WithoutUniqueKeys() JsonObjectAggBuilder {
+	return b.setUniqueKeys(jsonWithoutUniqueKeys)
+}
+
+func (b JsonObjectAggBuilder) setUniqueKeys(uniqueKeys jsonUniqueKeys) JsonObjectAggBuilder {
+	newBuilder := b
+	newBuilder.options.uniqueKeys = uniqueKeys
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// Returning sets the type of the result (defaults to json).
+func (b JsonObjectAggBuilder) Returning(typ types.Type) JsonObjectAggBuilder {
+	newBuilder := b
+	newBuilder.options.returning = typ
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// Filter adds a filter to the aggregate function.
+// Multiple calls to Filter are joined with AND.
+func (b JsonObjectAggBuilder) Filter(cond Exp) JsonObjectAggBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.filterConjunction, b.filterConjunction, 1)
+
+	newBuilder.filterConjunction = append(newBuilder.filterConjunction, cond)
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+func (b JsonObjectAggBuilder) WriteSQL(sb *SQLBuilder) {
+	sb.WriteString("JSON_OBJECTAGG(")
+	b.key.WriteSQL(sb)
+	sb.WriteString(" VALUE ")
+	b.value.WriteSQL(sb)
+	b.options.WriteSQL(sb)
+	sb.WriteRune(')')
+
+	writeFilter(sb, b.filterConjunction)
+}
+
+// --- SQL/JSON query functions
+
+// JsonBehavior is the behavior of a SQL/JSON query function for ON EMPTY and ON ERROR clauses.
+type JsonBehavior struct {
+	keyword string
+	exp     Exp
+}
+
+var (
+	JsonBehaviorError       = JsonBehavior{keyword: "ERROR"}
+	JsonBehaviorNull        = JsonBehavior{keyword: "NULL"}
+	JsonBehaviorTrue        = JsonBehavior{keyword: "TRUE"}
+	JsonBehaviorFalse       = JsonBehavior{keyword: "FALSE"}
+	JsonBehaviorUnknown     = JsonBehavior{keyword: "UNKNOWN"}
+	JsonBehaviorEmptyArray  = JsonBehavior{keyword: "EMPTY ARRAY"}
+	JsonBehaviorEmptyObject = JsonBehavior{keyword: "EMPTY OBJECT"}
+)
+
+// JsonBehaviorDefault returns the value of the expression.
+func JsonBehaviorDefault(exp Exp) JsonBehavior {
+	return JsonBehavior{keyword: "DEFAULT", exp: exp}
+}
+
+var ErrJsonInvalidBehavior = errors.New("json: invalid behavior")
+
+func (b JsonBehavior) writeSQL(sb *SQLBuilder, on string, allowed ...string) {
+	if b.keyword == "" {
+		return
+	}
+	if sb.Validating() && !slices.Contains(allowed, b.keyword) {
+		sb.AddError(fmt.Errorf("%w: %s ON %s", ErrJsonInvalidBehavior, b.keyword, on))
+		return
+	}
+
+	sb.WriteRune(' ')
+	sb.WriteString(b.keyword)
+	if b.exp != nil {
+		sb.WriteRune(' ')
+		b.exp.WriteSQL(sb)
+	}
+	sb.WriteString(" ON ")
+	sb.WriteString(on)
+}
+
+type jsonPassingArg struct {
+	value Exp
+	name  string
+}
+
+// jsonQueryArgs are the common arguments of the SQL/JSON query functions.
+type jsonQueryArgs struct {
+	contextItem Exp
+	path        Exp
+	passing     []jsonPassingArg
+}
+
+func (a jsonQueryArgs) withPassing(value Exp, name string) jsonQueryArgs {
+	newArgs := a
+	cloneSlice(&newArgs.passing, a.passing, 1)
+
+	newArgs.passing = append(newArgs.passing, jsonPassingArg{value: value, name: name})
+	return newArgs
+}
+
+func (a jsonQueryArgs) WriteSQL(sb *SQLBuilder) {
+	a.contextItem.WriteSQL(sb)
+	sb.WriteString(", ")
+	a.path.WriteSQL(sb)
+	a.writePassing(sb)
+}
+
+func (a jsonQueryArgs) writePassing(sb *SQLBuilder) {
+	if len(a.passing) > 0 {
+		sb.WriteString(" PASSING ")
+		for i, arg := range a.passing {
+			if i > 0 {
+				sb.WriteRune(',')
+			}
+			arg.value.WriteSQL(sb)
+			sb.WriteString(" AS ")
+			writeQuotedIdentifier(sb, arg.name)
+		}
+	}
+}
+
+// JsonExists builds the JSON_EXISTS function to test whether the path applied to the context item yields any items.
+//
+// Example:
+//
+//	JsonExists(N("data"), String("$.tags[*] ? (@ == $tag)")).Passing(Arg("go"), "tag")
+//	// JSON_EXISTS(data, '$.tags[*] ? (@ == $tag)' PASSING $1 AS "tag")
+func JsonExists(contextItem, path Exp) JsonExistsBuilder {
+	b := JsonExistsBuilder{
+		args: jsonQueryArgs{contextItem: contextItem, path: path},
+	}
+	b.Exp = b // self-reference for base methods
+	return b
+}
+
+// JsonExistsBuilder builds the JSON_EXISTS function.
+type JsonExistsBuilder struct {
+	ExpBase
+	args    jsonQueryArgs
+	onError JsonBehavior
+}
+
+func (b JsonExistsBuilder) IsExp() {}
+
+// Passing adds a value that can be referenced in the path as $name.
+// The name is always quoted, so it must match the case used in the path.
+func (b JsonExistsBuilder) Passing(value Exp, name string) JsonExistsBuilder {
+	newBuilder := b
+	newBuilder.args = b.args.withPassing(value, name)
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// OnError sets the behavior on errors: JsonBehaviorError, JsonBehaviorTrue, JsonBehaviorFalse (the default) or JsonBehaviorUnknown.
+func (b JsonExistsBuilder) OnError(behavior JsonBehavior) JsonExistsBuilder {
+	newBuilder := b
+	newBuilder.onError = behavior
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+func (b JsonExistsBuilder) WriteSQL(sb *SQLBuilder) {
+	sb.WriteString("JSON_EXISTS(")
+	b.args.WriteSQL(sb)
+	b.onError.writeSQL(sb, "ERROR", "ERROR", "TRUE", "FALSE", "UNKNOWN")
+	sb.WriteRune(')')
+}
+
+// JsonValue builds the JSON_VALUE function to extract a scalar value at the path of the context item.
+//
+// Example:
+//
+//	JsonValue(N("data"), String("$.price")).Returning("numeric").OnError(JsonBehaviorDefault(Int(0)))
+//	// JSON_VALUE(data, '$.price' RETURNING numeric DEFAULT 0 ON ERROR)
+func JsonValue(contextItem, path Exp) JsonValueBuilder {
+	b := JsonValueBuilder{
+		args: jsonQueryArgs{contextItem: contextItem, path: path},
+	}
+	b.Exp = b // self-reference for base methods
+	return b
+}
+
+// JsonValueBuilder builds the JSON_VALUE function.
+type JsonValueBuilder struct {
+	ExpBase
+	args      jsonQueryArgs
+	returning types.Type
+	onEmpty   JsonBehavior
+	onError   JsonBehavior
+}
+
+func (b JsonValueBuilder) IsExp() {}
+
+// Passing adds a value that can be referenced in the path as $name.
+// The name is always quoted, so it must match the case used in the path.
+func (b JsonValueBuilder) Passing(value Exp, name string) JsonValueBuilder {
+	newBuilder := b
+	newBuilder.args = b.args.withPassing(value, name)
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// Returning sets the type of the result (defaults to text).
+func (b JsonValueBuilder) Returning(typ types.Type) JsonValueBuilder {
+	newBuilder := b
+	newBuilder.returning = typ
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// OnEmpty sets the behavior if the path yields no value: JsonBehaviorError, JsonBehaviorNull (the default) or JsonBehaviorDefault.
+func (b JsonValueBuilder) OnEmpty(behavior JsonBehavior) JsonValueBuilder {
+	newBuilder := b
+	newBuilder.onEmpty = behavior
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// OnError sets the behavior on errors: JsonBehaviorError, JsonBehaviorNull (the default) or JsonBehaviorDefault.
+func (b JsonValueBuilder) OnError(behavior JsonBehavior) JsonValueBuilder {
+	newBuilder := b
+	newBuilder.onError = behavior
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+func (b JsonValueBuilder) WriteSQL(sb *SQLBuilder) {
+	sb.WriteString("JSON_VALUE(")
+	b.args.WriteSQL(sb)
+	writeJsonReturning(sb, b.returning)
+	b.onEmpty.writeSQL(sb, "EMPTY", "ERROR", "NULL", "DEFAULT")
+	b.onError.writeSQL(sb, "ERROR", "ERROR", "NULL", "DEFAULT")
+	sb.WriteRune(')')
+}
+
+// JsonQuery builds the JSON_QUERY function to extract JSON at the path of the context item.
+//
+// Example:
+//
+//	JsonQuery(N("data"), String("$.tags[*]")).WithWrapper()
+//	// JSON_QUERY(data, '$.tags[*]' WITH WRAPPER)
+func JsonQuery(contextItem, path Exp) JsonQueryBuilder {
+	b := JsonQueryBuilder{
+		args: jsonQueryArgs{contextItem: contextItem, path: path},
+	}
+	b.Exp = b // self-reference for base methods
+	return b
+}
+
+// JsonQueryBuilder builds the JSON_QUERY function.
+type JsonQueryBuilder struct {
+	ExpBase
+	args      jsonQueryArgs
+	returning types.Type
+	wrapper   string
+	quotes    string
+	onEmpty   JsonBehavior
+	onError   JsonBehavior
+}
+
+func (b JsonQueryBuilder) IsExp() {}
+
+// Passing adds a value that can be referenced in the path as $name.
+// The name is always quoted, so it must match the case used in the path.
+func (b JsonQueryBuilder) Passing(value Exp, name string) JsonQueryBuilder {
+	newBuilder := b
+	newBuilder.args = b.args.withPassing(value, name)
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// Returning sets the type of the result (defaults to jsonb).
+func (b JsonQueryBuilder) Returning(typ types.Type) JsonQueryBuilder {
+	newBuilder := b
+	newBuilder.returning = typ
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// WithWrapper always wraps the result in an array.
+// This is needed if the path yields multiple values.
+func (b JsonQueryBuilder) WithWrapper() JsonQueryBuilder {
+	return b.setWrapper("WITH WRAPPER")
+}
+
+// WithConditionalWrapper wraps the result in an array if the path yields multiple values or a single scalar.
+func (b JsonQueryBuilder) WithConditionalWrapper() JsonQueryBuilder {
+	return b.setWrapper("WITH CONDITIONAL WRAPPER")
+}
+
+// WithoutWrapper does not wrap the result (the default).
+func (b JsonQueryBuilder) WithoutWrapper() JsonQueryBuilder {
+	return b.setWrapper("WITHOUT WRAPPER")
+}
+
+func (b JsonQueryBuilder) setWrapper(wrapper string) JsonQueryBuilder {
+	newBuilder := b
+	newBuilder.wrapper = wrapper
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// KeepQuotes keeps the quotes of a scalar string result (the default).
+func (b JsonQueryBuilder) KeepQuotes() JsonQueryBuilder {
+	return b.setQuotes("KEEP QUOTES")
+}
+
+// OmitQuotes omits the quotes of a scalar string result.
+func (b JsonQueryBuilder) OmitQuotes() JsonQueryBuilder {
+	return b.setQuotes("OMIT QUOTES")
+}
+
+func (b JsonQueryBuilder) setQuotes(quotes string) JsonQueryBuilder {
+	newBuilder := b
+	newBuilder.quotes = quotes
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// OnEmpty sets the behavior if the path yields no value:
+// JsonBehaviorError, JsonBehaviorNull (the default), JsonBehaviorEmptyArray, JsonBehaviorEmptyObject or JsonBehaviorDefault.
+func (b JsonQueryBuilder) OnEmpty(behavior JsonBehavior) JsonQueryBuilder {
+	newBuilder := b
+	newBuilder.onEmpty = behavior
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+// OnError sets the behavior on errors:
+// JsonBehaviorError, JsonBehaviorNull (the default), JsonBehaviorEmptyArray, JsonBehaviorEmptyObject or JsonBehaviorDefault.
+func (b JsonQueryBuilder) OnError(behavior JsonBehavior) JsonQueryBuilder {
+	newBuilder := b
+	newBuilder.onError = behavior
+
+	newBuilder.Exp = newBuilder // self-reference for base methods
+	return newBuilder
+}
+
+var ErrJsonQueryWrapperAndOmitQuotes = errors.New("json query: OMIT QUOTES cannot be used with WITH WRAPPER")
+
+func (b JsonQueryBuilder) WriteSQL(sb *SQLBuilder) {
+	if b.quotes == "OMIT QUOTES" && strings.HasPrefix(b.wrapper, "WITH ") {
+		sb.AddError(ErrJsonQueryWrapperAndOmitQuotes)
+		return
+	}
+
+	sb.WriteString("JSON_QUERY(")
+	b.args.WriteSQL(sb)
+	writeJsonReturning(sb, b.returning)
+	if b.wrapper != "" {
+		sb.WriteRune(' ')
+		sb.WriteString(b.wrapper)
+	}
+	if b.quotes != "" {
+		sb.WriteRune(' ')
+		sb.WriteString(b.quotes)
+	}
+	b.onEmpty.writeSQL(sb, "EMPTY", "ERROR", "NULL", "EMPTY ARRAY", "EMPTY OBJECT", "DEFAULT")
+	b.onError.writeSQL(sb, "ERROR", "ERROR", "NULL", "EMPTY ARRAY", "EMPTY OBJECT", "DEFAULT")
+	sb.WriteRune(')')
+}
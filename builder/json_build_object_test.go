@@ -4,10 +4,12 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/networkteam/qrb"
 	"github.com/networkteam/qrb/builder"
 	"github.com/networkteam/qrb/fn"
+	"github.com/networkteam/qrb/internal/testhelper"
 )
 
 func TestJsonBuildObject(t *testing.T) {
@@ -221,3 +223,142 @@ func BenchmarkJsonBuildObject_Write(b *testing.B) {
 	_ = sql
 	_ = args
 }
+
+func TestSQLJsonFunctions(t *testing.T) {
+	t.Run("json_object", func(t *testing.T) {
+		b := qrb.JsonObject().
+			Prop("name", qrb.N("name")).
+			Prop("email", qrb.N("email")).
+			PropIf(false, "phone", qrb.N("phone")).
+			AbsentOnNull().
+			WithUniqueKeys().
+			Returning("jsonb")
+
+		testhelper.AssertSQLWriterEquals(t,
+			`JSON_OBJECT('name' VALUE name, 'email' VALUE email ABSENT ON NULL WITH UNIQUE KEYS RETURNING jsonb)`,
+			nil,
+			b,
+		)
+	})
+
+	t.Run("json_array", func(t *testing.T) {
+		b := qrb.JsonArray(qrb.Int(1), qrb.String("foo")).Append(qrb.N("x")).NullOnNull()
+
+		testhelper.AssertSQLWriterEquals(t, `JSON_ARRAY(1, 'foo', x NULL ON NULL)`, nil, b)
+	})
+
+	t.Run("json_array with query", func(t *testing.T) {
+		b := qrb.JsonArrayQuery(qrb.Select(qrb.N("id")).From(qrb.N("users"))).Returning("jsonb")
+
+		testhelper.AssertSQLWriterEquals(t, `JSON_ARRAY(SELECT id FROM users RETURNING jsonb)`, nil, b)
+	})
+
+	t.Run("json_array with query and values", func(t *testing.T) {
+		b := qrb.JsonArrayQuery(qrb.Select(qrb.N("id")).From(qrb.N("users"))).Append(qrb.Int(1))
+
+		_, _, err := qrb.Build(b).ToSQL()
+		require.ErrorIs(t, err, builder.ErrJsonArrayQueryWithValues)
+	})
+
+	t.Run("json_arrayagg", func(t *testing.T) {
+		q := qrb.Select(
+			qrb.JsonArrayAgg(qrb.N("name")).
+				OrderBy(qrb.N("name")).Desc().NullsLast().
+				AbsentOnNull().
+				Returning("jsonb").
+				Filter(qrb.N("active")),
+		).From(qrb.N("users"))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT JSON_ARRAYAGG(name ORDER BY name DESC NULLS LAST ABSENT ON NULL RETURNING jsonb) FILTER (WHERE active) FROM users`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("json_objectagg", func(t *testing.T) {
+		q := qrb.Select(qrb.JsonObjectAgg(qrb.N("k"), qrb.N("v")).NullOnNull().WithoutUniqueKeys()).From(qrb.N("settings"))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT JSON_OBJECTAGG(k VALUE v NULL ON NULL WITHOUT UNIQUE KEYS) FROM settings`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("json_exists passing quoted names", func(t *testing.T) {
+		q := qrb.Select(qrb.N("id")).From(qrb.N("posts")).Where(
+			qrb.JsonExists(qrb.N("data"), qrb.String("$.tags[*] ? (@ == $myTag || @ == $x)")).
+				Passing(qrb.Arg("go"), "myTag").
+				Passing(qrb.Arg("sql"), `x" FALSE ON ERROR) OR (true`),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT id FROM posts WHERE JSON_EXISTS(data, '$.tags[*] ? (@ == $myTag || @ == $x)' PASSING $1 AS "myTag",$2 AS "x"" FALSE ON ERROR) OR (true")`,
+			[]any{"go", "sql"},
+			q,
+		)
+	})
+
+	t.Run("json_exists", func(t *testing.T) {
+		q := qrb.Select(qrb.N("id")).From(qrb.N("posts")).Where(
+			qrb.JsonExists(qrb.N("data"), qrb.String("$.tags[*] ? (@ == $tag)")).
+				Passing(qrb.Arg("go"), "tag").
+				OnError(builder.JsonBehaviorFalse),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT id FROM posts WHERE JSON_EXISTS(data, '$.tags[*] ? (@ == $tag)' PASSING $1 AS "tag" FALSE ON ERROR)`,
+			[]any{"go"},
+			q,
+		)
+	})
+
+	t.Run("json_value", func(t *testing.T) {
+		b := qrb.JsonValue(qrb.N("data"), qrb.String("$.price")).
+			Returning("numeric(10,2)").
+			OnEmpty(builder.JsonBehaviorNull).
+			OnError(builder.JsonBehaviorDefault(qrb.Int(0))).
+			Gt(qrb.Arg(10))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`JSON_VALUE(data, '$.price' RETURNING numeric(10,2) NULL ON EMPTY DEFAULT 0 ON ERROR) > $1`,
+			[]any{10},
+			b,
+		)
+	})
+
+	t.Run("json_value with invalid behavior", func(t *testing.T) {
+		b := qrb.JsonValue(qrb.N("data"), qrb.String("$.price")).OnError(builder.JsonBehaviorEmptyArray)
+
+		_, _, err := qrb.Build(b).ToSQL()
+		require.ErrorIs(t, err, builder.ErrJsonInvalidBehavior)
+	})
+
+	t.Run("json_query", func(t *testing.T) {
+		b := qrb.JsonQuery(qrb.N("data"), qrb.String("$.tags[*]")).
+			Returning("jsonb").
+			WithConditionalWrapper().
+			OnEmpty(builder.JsonBehaviorEmptyArray).
+			OnError(builder.JsonBehaviorError)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`JSON_QUERY(data, '$.tags[*]' RETURNING jsonb WITH CONDITIONAL WRAPPER EMPTY ARRAY ON EMPTY ERROR ON ERROR)`,
+			nil,
+			b,
+		)
+	})
+
+	t.Run("json_query with wrapper and omit quotes", func(t *testing.T) {
+		b := qrb.JsonQuery(qrb.N("data"), qrb.String("$.name")).WithWrapper().OmitQuotes()
+
+		_, _, err := qrb.Build(b).ToSQL()
+		require.ErrorIs(t, err, builder.ErrJsonQueryWrapperAndOmitQuotes)
+	})
+
+	t.Run("json_query omit quotes", func(t *testing.T) {
+		b := qrb.JsonQuery(qrb.N("data"), qrb.String("$.name")).WithoutWrapper().OmitQuotes()
+
+		testhelper.AssertSQLWriterEquals(t, `JSON_QUERY(data, '$.name' WITHOUT WRAPPER OMIT QUOTES)`, nil, b)
+	})
+}
@@ -0,0 +1,331 @@
+package builder
+
+import (
+	"regexp"
+	"strconv"
+)
+
+// JsonProjection starts a projection of the given table into nested jsonb objects.
+// Fields and relations to other projections are declared on the builder, the query is built with
+// JsonProjectionBuilder.Select or JsonProjectionBuilder.SelectAgg.
+//
+// Relations are selected with LEFT JOIN LATERAL subqueries, so every level can have its own conditions, ordering and limit.
+// Projections of related tables need an alias that is different from their parent, if they refer to the same table.
+//
+// Example:
+//
+//	JsonProjection(N("authors")).As("a").
+//		Field("id", "name").
+//		HasMany("posts", JsonProjection(N("posts")).As("p").Field("id", "title").OrderBy(N("p.published_at")).Desc().Limit(5), "author_id", "id").
+//		Select()
+//	// SELECT jsonb_build_object('id',a.id,'name',a.name,'posts',posts_json.json) AS json FROM authors AS a
+//	// LEFT JOIN LATERAL (
+//	//   SELECT COALESCE(jsonb_agg(sub.json ORDER BY sub.sort_0 DESC),'[]') AS json FROM (
+//	//     SELECT jsonb_build_object('id',p.id,'title',p.title) AS json, p.published_at AS sort_0
+//	//     FROM posts AS p WHERE p.author_id = a.id ORDER BY p.published_at DESC LIMIT 5
+//	//   ) AS sub
+//	// ) AS posts_json ON true
+func JsonProjection(table Identer) JsonProjectionBuilder {
+	return JsonProjectionBuilder{
+		table: table,
+	}
+}
+
+// JsonProjector is implemented by JsonProjectionBuilder and its sub-builders to be used as the target of a relation.
+type JsonProjector interface {
+	jsonProjection() JsonProjectionBuilder
+}
+
+// JsonProjectionBuilder builds a projection of a table into nested jsonb objects.
+type JsonProjectionBuilder struct {
+	table            Identer
+	alias            string
+	props            []jsonProjectionProp
+	whereConjunction []Exp
+	orderBys         []orderByClause
+	limit            Exp
+}
+
+type jsonProjectionRelationType int
+
+const (
+	jsonProjectionHasMany jsonProjectionRelationType = iota + 1
+	jsonProjectionBelongsTo
+)
+
+type jsonProjectionProp struct {
+	key      string
+	column   string
+	exp      Exp
+	relation *jsonProjectionRelation
+}
+
+type jsonProjectionRelation struct {
+	relationType jsonProjectionRelationType
+	target       JsonProjectionBuilder
+	column       string
+	targetColumn string
+}
+
+// As sets the alias of the table, which is used to qualify the columns.
+func (b JsonProjectionBuilder) As(alias string) JsonProjectionBuilder {
+	newBuilder := b
+	newBuilder.alias = alias
+	return newBuilder
+}
+
+func (b JsonProjectionBuilder) addProp(prop jsonProjectionProp) JsonProjectionBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.props, b.props, 1)
+
+	newBuilder.props = append(newBuilder.props, prop)
+	return newBuilder
+}
+
+// Field adds columns of the table as properties with the column name as key.
+func (b JsonProjectionBuilder) Field(column string, columns ...string) JsonProjectionBuilder {
+	newBuilder := b.addProp(jsonProjectionProp{key: column, column: column})
+	for _, c := range columns {
+		newBuilder = newBuilder.addProp(jsonProjectionProp{key: c, column: c})
+	}
+	return newBuilder
+}
+
+// Prop adds a property with an arbitrary expression as value.
+// The expression can refer to columns of the table qualified by its alias.
+func (b JsonProjectionBuilder) Prop(key string, value Exp) JsonProjectionBuilder {
+	return b.addProp(jsonProjectionProp{key: key, exp: value})
+}
+
+// HasMany adds a property with an array of related objects, where childColumn of the child table refers to parentColumn of this table.
+// The array is empty if there are no related rows.
+func (b JsonProjectionBuilder) HasMany(key string, child JsonProjector, childColumn, parentColumn string) JsonProjectionBuilder {
+	return b.addProp(jsonProjectionProp{
+		key: key,
+		relation: &jsonProjectionRelation{
+			relationType: jsonProjectionHasMany,
+			target:       child.jsonProjection(),
+			column:       parentColumn,
+			targetColumn: childColumn,
+		},
+	})
+}
+
+// BelongsTo adds a property with a related object, where column of this table refers to targetColumn of the target table.
+// The property is null if there is no related row.
+func (b JsonProjectionBuilder) BelongsTo(key string, target JsonProjector, column, targetColumn string) JsonProjectionBuilder {
+	return b.addProp(jsonProjectionProp{
+		key: key,
+		relation: &jsonProjectionRelation{
+			relationType: jsonProjectionBelongsTo,
+			target:       target.jsonProjection(),
+			column:       column,
+			targetColumn: targetColumn,
+		},
+	})
+}
+
+// Where adds a condition for the rows of the table.
+// Multiple calls to Where are joined with AND.
+func (b JsonProjectionBuilder) Where(cond Exp) JsonProjectionBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.whereConjunction, b.whereConjunction, 1)
+
+	newBuilder.whereConjunction = append(newBuilder.whereConjunction, cond)
+	return newBuilder
+}
+
+// OrderBy adds an expression to sort the rows of the table.
+func (b JsonProjectionBuilder) OrderBy(exp Exp) OrderByJsonProjectionBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.orderBys, b.orderBys, 1)
+
+	newBuilder.orderBys = append(newBuilder.orderBys, orderByClause{
+		exp: exp,
+	})
+	return OrderByJsonProjectionBuilder{
+		JsonProjectionBuilder: newBuilder,
+	}
+}
+
+// OrderByJsonProjectionBuilder allows to set the sort order of the last ORDER BY expression.
+type OrderByJsonProjectionBuilder struct {
+	JsonProjectionBuilder
+}
+
+func (b OrderByJsonProjectionBuilder) Asc() OrderByJsonProjectionBuilder {
+	return b.setOrder(sortOrderAsc)
+}
+
+func (b OrderByJsonProjectionBuilder) Desc() OrderByJsonProjectionBuilder {
+	return b.setOrder(sortOrderDesc)
+}
+
+func (b OrderByJsonProjectionBuilder) setOrder(order sortOrder) OrderByJsonProjectionBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.orderBys, b.orderBys, 0)
+
+	newBuilder.orderBys[len(newBuilder.orderBys)-1].order = order
+	return newBuilder
+}
+
+// Limit sets the maximum number of rows of the table (per parent row for relations).
+func (b JsonProjectionBuilder) Limit(limit Exp) JsonProjectionBuilder {
+	newBuilder := b
+	newBuilder.limit = limit
+	return newBuilder
+}
+
+// Select builds a query that selects one jsonb object per row in the column json.
+func (b JsonProjectionBuilder) Select() SelectBuilder {
+	return b.selectObjects(nil)
+}
+
+// SelectAgg builds a query that selects a single jsonb array of all objects in the column json.
+func (b JsonProjectionBuilder) SelectAgg() SelectBuilder {
+	return b.selectAgg(nil)
+}
+
+func (b JsonProjectionBuilder) jsonProjection() JsonProjectionBuilder {
+	return b
+}
+
+func (b JsonProjectionBuilder) qualifier() string {
+	if b.alias != "" {
+		return b.alias
+	}
+	return b.table.Ident()
+}
+
+func (b JsonProjectionBuilder) object() JsonBuildObjectBuilder {
+	aliases := b.relationAliases()
+	obj := JsonBuildObject(true)
+	for i, prop := range b.props {
+		switch {
+		case prop.relation != nil:
+			obj = obj.Prop(prop.key, N(aliases[i]+".json"))
+		case prop.column != "":
+			obj = obj.Prop(prop.key, N(b.qualifier()+"."+prop.column))
+		default:
+			obj = obj.Prop(prop.key, prop.exp)
+		}
+	}
+	return obj
+}
+
+var simpleJsonProjectionKeyRegex = regexp.MustCompile(`\A[a-z_][a-z0-9_]*\z`)
+
+// relationAliases returns the aliases of the lateral joins for relations by index of the property.
+// The alias is derived from the property key if possible, otherwise from the index of the relation.
+func (b JsonProjectionBuilder) relationAliases() map[int]string {
+	aliases := make(map[int]string)
+	used := make(map[string]struct{})
+	relationIdx := 0
+	for i, prop := range b.props {
+		if prop.relation == nil {
+			continue
+		}
+		alias := prop.key + "_json"
+		if _, exists := used[alias]; exists || !simpleJsonProjectionKeyRegex.MatchString(prop.key) {
+			alias = "rel_" + strconv.Itoa(relationIdx) + "_json"
+			for n := 1; ; n++ {
+				if _, exists := used[alias]; !exists {
+					break
+				}
+				alias = "rel_" + strconv.Itoa(relationIdx) + "_" + strconv.Itoa(n) + "_json"
+			}
+		}
+		used[alias] = struct{}{}
+		aliases[i] = alias
+		relationIdx++
+	}
+	return aliases
+}
+
+// selectObjects builds the select of the objects with an additional correlation condition to the parent.
+func (b JsonProjectionBuilder) selectObjects(correlation Exp, extraSelects ...Exp) SelectBuilder {
+	var sb SelectBuilder
+	q := sb.Select(b.object()).As("json")
+	for i, exp := range extraSelects {
+		q = q.Select(exp).As("sort_" + strconv.Itoa(i))
+	}
+	return b.applyFrom(q.SelectBuilder, correlation)
+}
+
+func (b JsonProjectionBuilder) applyFrom(q SelectBuilder, correlation Exp) SelectBuilder {
+	if b.alias != "" {
+		q = q.From(b.table).As(b.alias).SelectBuilder
+	} else {
+		q = q.From(b.table).SelectBuilder
+	}
+
+	aliases := b.relationAliases()
+	for i, prop := range b.props {
+		if prop.relation == nil {
+			continue
+		}
+		rel := prop.relation
+		relCorrelation := N(rel.target.qualifier() + "." + rel.targetColumn).Eq(N(b.qualifier() + "." + rel.column))
+
+		var sub SelectBuilder
+		if rel.relationType == jsonProjectionHasMany {
+			sub = rel.target.selectAgg(relCorrelation)
+		} else {
+			sub = rel.target.selectObjects(relCorrelation)
+		}
+		q = q.LeftJoinLateral(sub).As(aliases[i]).On(Bool(true))
+	}
+
+	if correlation != nil {
+		q = q.Where(correlation)
+	}
+	for _, cond := range b.whereConjunction {
+		q = q.Where(cond)
+	}
+
+	cloneSlice(&q.parts.orderBys, q.parts.orderBys, len(b.orderBys))
+	q.parts.orderBys = append(q.parts.orderBys, b.orderBys...)
+
+	if b.limit != nil {
+		q = q.Limit(b.limit)
+	}
+	return q
+}
+
+// selectAgg builds the select of a jsonb array of the objects with an additional correlation condition to the parent.
+func (b JsonProjectionBuilder) selectAgg(correlation Exp) SelectBuilder {
+	var sb SelectBuilder
+
+	// Without a limit, objects can be aggregated directly in the requested order.
+	if b.limit == nil {
+		agg := Agg("jsonb_agg", []Exp{b.object()})
+		agg.orderBys = b.orderBys
+		agg.Exp = agg // self-reference for base methods
+
+		q := sb.Select(Coalesce(agg, String("[]"))).As("json").SelectBuilder
+		withoutOrder := b
+		withoutOrder.orderBys = nil
+		return withoutOrder.applyFrom(q, correlation)
+	}
+
+	// With a limit, rows are sorted and limited in a subquery and aggregated by the selected sort expressions.
+	sortExps := make([]Exp, len(b.orderBys))
+	aggOrderBys := make([]orderByClause, len(b.orderBys))
+	for i, orderBy := range b.orderBys {
+		sortExps[i] = orderBy.exp
+		aggOrderBys[i] = orderByClause{
+			exp:   N("sub.sort_" + strconv.Itoa(i)),
+			order: orderBy.order,
+			nulls: orderBy.nulls,
+		}
+	}
+
+	agg := Agg("jsonb_agg", []Exp{N("sub.json")})
+	agg.orderBys = aggOrderBys
+	agg.Exp = agg // self-reference for base methods
+
+	return sb.
+		Select(Coalesce(agg, String("[]"))).As("json").
+		From(b.selectObjects(correlation, sortExps...)).As("sub").
+		SelectBuilder
+}
@@ -0,0 +1,125 @@
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func TestJsonProjection(t *testing.T) {
+	t.Run("has many with order and limit and belongs to", func(t *testing.T) {
+		q := qrb.JsonProjection(qrb.N("authors")).As("a").
+			Field("id", "name").
+			HasMany("posts",
+				qrb.JsonProjection(qrb.N("posts")).As("p").
+					Field("id", "title").
+					BelongsTo("category", qrb.JsonProjection(qrb.N("categories")).As("c").Field("name"), "category_id", "id").
+					Where(qrb.N("p.published").Eq(qrb.Bool(true))).
+					OrderBy(qrb.N("p.published_at")).Desc().
+					Limit(qrb.Int(5)),
+				"author_id", "id",
+			).
+			Where(qrb.N("a.id").Eq(qrb.Arg(42))).
+			Select()
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT jsonb_build_object('id', a.id, 'name', a.name, 'posts', posts_json.json) AS json
+			FROM authors AS a
+			LEFT JOIN LATERAL (
+				SELECT COALESCE(jsonb_agg(sub.json ORDER BY sub.sort_0 DESC), '[]') AS json
+				FROM (
+					SELECT jsonb_build_object('id', p.id, 'title', p.title, 'category', category_json.json) AS json, p.published_at AS sort_0
+					FROM posts AS p
+					LEFT JOIN LATERAL (
+						SELECT jsonb_build_object('name', c.name) AS json FROM categories AS c WHERE c.id = p.category_id
+					) AS category_json ON true
+					WHERE p.author_id = a.id AND p.published = true
+					ORDER BY p.published_at DESC
+					LIMIT 5
+				) AS sub
+			) AS posts_json ON true
+			WHERE a.id = $1`,
+			[]any{42},
+			q,
+		)
+	})
+
+	t.Run("aggregated without limit", func(t *testing.T) {
+		q := qrb.JsonProjection(qrb.N("authors")).
+			Field("id").
+			HasMany("posts", qrb.JsonProjection(qrb.N("posts")).Field("title").OrderBy(qrb.N("posts.title")).Asc(), "author_id", "id").
+			OrderBy(qrb.N("authors.name")).
+			SelectAgg()
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT COALESCE(jsonb_agg(jsonb_build_object('id', authors.id, 'posts', posts_json.json) ORDER BY authors.name), '[]') AS json
+			FROM authors
+			LEFT JOIN LATERAL (
+				SELECT COALESCE(jsonb_agg(jsonb_build_object('title', posts.title) ORDER BY posts.title ASC), '[]') AS json
+				FROM posts
+				WHERE posts.author_id = authors.id
+			) AS posts_json ON true`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("limit as argument and zero limit", func(t *testing.T) {
+		q := qrb.JsonProjection(qrb.N("authors")).
+			Field("id").
+			HasMany("posts", qrb.JsonProjection(qrb.N("posts")).Field("title").Limit(qrb.Arg(3)), "author_id", "id").
+			Limit(qrb.Int(0)).
+			Select()
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT jsonb_build_object('id', authors.id, 'posts', posts_json.json) AS json
+			FROM authors
+			LEFT JOIN LATERAL (
+				SELECT COALESCE(jsonb_agg(sub.json), '[]') AS json
+				FROM (
+					SELECT jsonb_build_object('title', posts.title) AS json
+					FROM posts
+					WHERE posts.author_id = authors.id
+					LIMIT $1
+				) AS sub
+			) AS posts_json ON true
+			LIMIT 0`,
+			[]any{3},
+			q,
+		)
+	})
+
+	t.Run("alias set after fields", func(t *testing.T) {
+		q := qrb.JsonProjection(qrb.N("authors")).
+			Field("id").
+			Prop("upper_name", qrb.Func("upper", qrb.N("a.name"))).
+			As("a").
+			Select()
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT jsonb_build_object('id', a.id, 'upper_name', upper(a.name)) AS json FROM authors AS a`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("sibling relations to the same table", func(t *testing.T) {
+		q := qrb.JsonProjection(qrb.N("posts")).As("p").
+			Field("id").
+			BelongsTo("author", qrb.JsonProjection(qrb.N("users")).As("u").Field("name"), "author_id", "id").
+			BelongsTo("editor", qrb.JsonProjection(qrb.N("users")).As("u").Field("name"), "editor_id", "id").
+			BelongsTo("last reviewer", qrb.JsonProjection(qrb.N("users")).As("u").Field("name"), "reviewer_id", "id").
+			Select()
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT jsonb_build_object('id', p.id, 'author', author_json.json, 'editor', editor_json.json, 'last reviewer', rel_2_json.json) AS json
+			FROM posts AS p
+			LEFT JOIN LATERAL (SELECT jsonb_build_object('name', u.name) AS json FROM users AS u WHERE u.id = p.author_id) AS author_json ON true
+			LEFT JOIN LATERAL (SELECT jsonb_build_object('name', u.name) AS json FROM users AS u WHERE u.id = p.editor_id) AS editor_json ON true
+			LEFT JOIN LATERAL (SELECT jsonb_build_object('name', u.name) AS json FROM users AS u WHERE u.id = p.reviewer_id) AS rel_2_json ON true`,
+			nil,
+			q,
+		)
+	})
+}
@@ -0,0 +1,355 @@
+package builder
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/networkteam/qrb/types"
+)
+
+// JSON_TABLE (
+//     context_item, path_expression [ AS json_path_name ] [ PASSING { value AS varname } [, ...] ]
+//     COLUMNS ( json_table_column [, ...] )
+//     [ { ERROR | EMPTY [ARRAY]} ON ERROR ]
+// )
+
+// JsonTable builds a JSON_TABLE function that produces a relational table from JSON data, to be used as a FROM item.
+// JSON_TABLE is implicitly lateral, so it can reference columns of preceding FROM items.
+//
+// Example:
+//
+//	JsonTable(N("w.payload"), String("$.items[*]")).
+//		Columns(
+//			JsonTableOrdinalityColumn("idx"),
+//			JsonTableColumn("id", "int").Path("$.id"),
+//			JsonTableNestedPath("$.tags[*]", JsonTableColumn("tag", "text").Path("$")),
+//		)
+//	// JSON_TABLE(w.payload, '$.items[*]' COLUMNS (idx FOR ORDINALITY,id int PATH '$.id',NESTED PATH '$.tags[*]' COLUMNS (tag text PATH '$')))
+func JsonTable(contextItem, path Exp) JsonTableBuilder {
+	return JsonTableBuilder{
+		args: jsonQueryArgs{contextItem: contextItem, path: path},
+	}
+}
+
+// JsonTableBuilder builds a JSON_TABLE function.
+type JsonTableBuilder struct {
+	args     jsonQueryArgs
+	pathName string
+	columns  []JsonTableColumnDefinition
+	onError  JsonBehavior
+}
+
+var (
+	_ FromExp        = JsonTableBuilder{}
+	_ FromLateralExp = JsonTableBuilder{}
+)
+
+func (b JsonTableBuilder) IsExp()            {}
+func (b JsonTableBuilder) isFromExp()        {}
+func (b JsonTableBuilder) isFromLateralExp() {}
+
+// PathName sets the name of the top-level path.
+func (b JsonTableBuilder) PathName(name string) JsonTableBuilder {
+	newBuilder := b
+	newBuilder.pathName = name
+	return newBuilder
+}
+
+// Passing adds a value that can be referenced in paths as $name.
+// The name is always quoted, so it must match the case used in the paths.
+func (b JsonTableBuilder) Passing(value Exp, name string) JsonTableBuilder {
+	newBuilder := b
+	newBuilder.args = b.args.withPassing(value, name)
+	return newBuilder
+}
+
+// Columns adds column definitions to the table.
+func (b JsonTableBuilder) Columns(columns ...JsonTableColumnDefinition) JsonTableBuilder {
+	newBuilder := b
+	cloneSlice(&newBuilder.columns, b.columns, len(columns))
+
+	newBuilder.columns = append(newBuilder.columns, columns...)
+	return newBuilder
+}
+
+// OnError sets the behavior on errors in the top-level path: JsonBehaviorError or JsonBehaviorEmptyArray (the default).
+func (b JsonTableBuilder) OnError(behavior JsonBehavior) JsonTableBuilder {
+	newBuilder := b
+	newBuilder.onError = behavior
+	return newBuilder
+}
+
+func (b JsonTableBuilder) WriteSQL(sb *SQLBuilder) {
+	if len(b.columns) == 0 {
+		sb.AddError(ErrJsonTableNoColumns)
+		return
+	}
+
+	sb.WriteString("JSON_TABLE(")
+	b.args.contextItem.WriteSQL(sb)
+	sb.WriteString(", ")
+	b.args.path.WriteSQL(sb)
+	if b.pathName != "" {
+		sb.WriteString(" AS ")
+		sb.WriteString(quoteIdentifierIfKeyword(b.pathName))
+	}
+	b.args.writePassing(sb)
+	writeJsonTableColumns(sb, b.columns)
+	b.onError.writeSQL(sb, "ERROR", "ERROR", "EMPTY ARRAY")
+	sb.WriteRune(')')
+}
+
+var ErrJsonTableNoColumns = errors.New("json table: no columns")
+
+func writeJsonTableColumns(sb *SQLBuilder, columns []JsonTableColumnDefinition) {
+	sb.WriteString(" COLUMNS (")
+	for i, column := range columns {
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		column.WriteSQL(sb)
+	}
+	sb.WriteRune(')')
+}
+
+// JsonTableColumnDefinition is a column definition of JSON_TABLE.
+type JsonTableColumnDefinition interface {
+	SQLWriter
+	isJsonTableColumnDefinition()
+}
+
+// --- Ordinality column
+
+// JsonTableOrdinalityColumn defines a column with the row number, starting with 1.
+func JsonTableOrdinalityColumn(name string) JsonTableColumnDefinition {
+	return jsonTableOrdinalityColumn{name: name}
+}
+
+type jsonTableOrdinalityColumn struct {
+	name string
+}
+
+func (c jsonTableOrdinalityColumn) isJsonTableColumnDefinition() {}
+
+func (c jsonTableOrdinalityColumn) WriteSQL(sb *SQLBuilder) {
+	sb.WriteString(quoteIdentifierIfKeyword(c.name))
+	sb.WriteString(" FOR ORDINALITY")
+}
+
+// --- Value column
+
+// name type [ FORMAT JSON [ENCODING UTF8]] [ PATH path_expression ]
+//     [ { WITHOUT | WITH { CONDITIONAL | [UNCONDITIONAL] } } [ ARRAY ] WRAPPER ]
+//     [ { KEEP | OMIT } QUOTES [ ON SCALAR STRING ] ]
+//     [ { ERROR | NULL | EMPTY { [ARRAY] | OBJECT } | DEFAULT expression } ON EMPTY ]
+//     [ { ERROR | NULL | EMPTY { [ARRAY] | OBJECT } | DEFAULT expression } ON ERROR ]
+
+// JsonTableColumn defines a column with a value extracted by a path (defaults to $.name).
+func JsonTableColumn(name string, typ types.Type) JsonTableColumnBuilder {
+	return JsonTableColumnBuilder{
+		name: name,
+		typ:  typ,
+	}
+}
+
+// JsonTableColumnBuilder builds a value column definition of JSON_TABLE.
+type JsonTableColumnBuilder struct {
+	name       string
+	typ        types.Type
+	formatJson bool
+	path       string
+	wrapper    string
+	quotes     string
+	onEmpty    JsonBehavior
+	onError    JsonBehavior
+}
+
+func (b JsonTableColumnBuilder) isJsonTableColumnDefinition() {}
+
+// Path sets the path to extract the value of the column.
+func (b JsonTableColumnBuilder) Path(path string) JsonTableColumnBuilder {
+	newBuilder := b
+	newBuilder.path = path
+	return newBuilder
+}
+
+// FormatJson extracts the value as JSON like JSON_QUERY, instead of a scalar value like JSON_VALUE.
+func (b JsonTableColumnBuilder) FormatJson() JsonTableColumnBuilder {
+	newBuilder := b
+	newBuilder.formatJson = true
+	return newBuilder
+}
+
+// WithWrapper always wraps the result in an array.
+func (b JsonTableColumnBuilder) WithWrapper() JsonTableColumnBuilder {
+	return b.setWrapper("WITH WRAPPER")
+}
+
+// WithConditionalWrapper wraps the result in an array if the path yields multiple values or a single scalar.
+func (b JsonTableColumnBuilder) WithConditionalWrapper() JsonTableColumnBuilder {
+	return b.setWrapper("WITH CONDITIONAL WRAPPER")
+}
+
+// WithoutWrapper does not wrap the result (the default).
+func (b JsonTableColumnBuilder) WithoutWrapper() JsonTableColumnBuilder {
+	return b.setWrapper("WITHOUT WRAPPER")
+}
+
+func (b JsonTableColumnBuilder) setWrapper(wrapper string) JsonTableColumnBuilder {
+	newBuilder := b
+	newBuilder.wrapper = wrapper
+	return newBuilder
+}
+
+// KeepQuotes keeps the quotes of a scalar string result (the default).
+func (b JsonTableColumnBuilder) KeepQuotes() JsonTableColumnBuilder {
+	return b.setQuotes("KEEP QUOTES")
+}
+
+// OmitQuotes omits the quotes of a scalar string result. It cannot be combined with WithWrapper or WithConditionalWrapper.
+func (b JsonTableColumnBuilder) OmitQuotes() JsonTableColumnBuilder {
+	return b.setQuotes("OMIT QUOTES")
+}
+
+func (b JsonTableColumnBuilder) setQuotes(quotes string) JsonTableColumnBuilder {
+	newBuilder := b
+	newBuilder.quotes = quotes
+	return newBuilder
+}
+
+// OnEmpty sets the behavior if the path yields no value:
+// JsonBehaviorError, JsonBehaviorNull (the default), JsonBehaviorEmptyArray, JsonBehaviorEmptyObject or JsonBehaviorDefault.
+func (b JsonTableColumnBuilder) OnEmpty(behavior JsonBehavior) JsonTableColumnBuilder {
+	newBuilder := b
+	newBuilder.onEmpty = behavior
+	return newBuilder
+}
+
+// OnError sets the behavior on errors:
+// JsonBehaviorError, JsonBehaviorNull (the default), JsonBehaviorEmptyArray, JsonBehaviorEmptyObject or JsonBehaviorDefault.
+func (b JsonTableColumnBuilder) OnError(behavior JsonBehavior) JsonTableColumnBuilder {
+	newBuilder := b
+	newBuilder.onError = behavior
+	return newBuilder
+}
+
+var ErrJsonTableColumnWrapperAndOmitQuotes = errors.New("json table: OMIT QUOTES cannot be used with WITH WRAPPER")
+
+func (b JsonTableColumnBuilder) WriteSQL(sb *SQLBuilder) {
+	if b.quotes == "OMIT QUOTES" && strings.HasPrefix(b.wrapper, "WITH ") {
+		sb.AddError(ErrJsonTableColumnWrapperAndOmitQuotes)
+		return
+	}
+
+	sb.WriteString(quoteIdentifierIfKeyword(b.name))
+	sb.WriteRune(' ')
+	expType(b.typ).WriteSQL(sb)
+	if b.formatJson {
+		sb.WriteString(" FORMAT JSON")
+	}
+	if b.path != "" {
+		sb.WriteString(" PATH ")
+		sb.WriteString(pqQuoteLiteral(b.path))
+	}
+	if b.wrapper != "" {
+		sb.WriteRune(' ')
+		sb.WriteString(b.wrapper)
+	}
+	if b.quotes != "" {
+		sb.WriteRune(' ')
+		sb.WriteString(b.quotes)
+	}
+	b.onEmpty.writeSQL(sb, "EMPTY", "ERROR", "NULL", "EMPTY ARRAY", "EMPTY OBJECT", "DEFAULT")
+	b.onError.writeSQL(sb, "ERROR", "ERROR", "NULL", "EMPTY ARRAY", "EMPTY OBJECT", "DEFAULT")
+}
+
+// --- Exists column
+
+// name type EXISTS [ PATH path_expression ] [ { ERROR | TRUE | FALSE | UNKNOWN } ON ERROR ]
+
+// JsonTableExistsColumn defines a column with the result of testing whether a path (defaults to $.name) yields any items.
+func JsonTableExistsColumn(name string, typ types.Type) JsonTableExistsColumnBuilder {
+	return JsonTableExistsColumnBuilder{
+		name: name,
+		typ:  typ,
+	}
+}
+
+// JsonTableExistsColumnBuilder builds an EXISTS column definition of JSON_TABLE.
+type JsonTableExistsColumnBuilder struct {
+	name    string
+	typ     types.Type
+	path    string
+	onError JsonBehavior
+}
+
+func (b JsonTableExistsColumnBuilder) isJsonTableColumnDefinition() {}
+
+// Path sets the path to test.
+func (b JsonTableExistsColumnBuilder) Path(path string) JsonTableExistsColumnBuilder {
+	newBuilder := b
+	newBuilder.path = path
+	return newBuilder
+}
+
+// OnError sets the behavior on errors: JsonBehaviorError, JsonBehaviorTrue, JsonBehaviorFalse (the default) or JsonBehaviorUnknown.
+func (b JsonTableExistsColumnBuilder) OnError(behavior JsonBehavior) JsonTableExistsColumnBuilder {
+	newBuilder := b
+	newBuilder.onError = behavior
+	return newBuilder
+}
+
+func (b JsonTableExistsColumnBuilder) WriteSQL(sb *SQLBuilder) {
+	sb.WriteString(quoteIdentifierIfKeyword(b.name))
+	sb.WriteRune(' ')
+	expType(b.typ).WriteSQL(sb)
+	sb.WriteString(" EXISTS")
+	if b.path != "" {
+		sb.WriteString(" PATH ")
+		sb.WriteString(pqQuoteLiteral(b.path))
+	}
+	b.onError.writeSQL(sb, "ERROR", "ERROR", "TRUE", "FALSE", "UNKNOWN")
+}
+
+// --- Nested path
+
+// NESTED [ PATH ] path_expression [ AS json_path_name ] COLUMNS ( json_table_column [, ...] )
+
+// JsonTableNestedPath defines columns extracted from a nested path. Each item of the nested path produces a separate row.
+func JsonTableNestedPath(path string, columns ...JsonTableColumnDefinition) JsonTableNestedPathBuilder {
+	return JsonTableNestedPathBuilder{
+		path:    path,
+		columns: columns,
+	}
+}
+
+// JsonTableNestedPathBuilder builds a NESTED PATH column definition of JSON_TABLE.
+type JsonTableNestedPathBuilder struct {
+	path     string
+	pathName string
+	columns  []JsonTableColumnDefinition
+}
+
+func (b JsonTableNestedPathBuilder) isJsonTableColumnDefinition() {}
+
+// As sets the name of the nested path.
+func (b JsonTableNestedPathBuilder) As(pathName string) JsonTableNestedPathBuilder {
+	newBuilder := b
+	newBuilder.pathName = pathName
+	return newBuilder
+}
+
+func (b JsonTableNestedPathBuilder) WriteSQL(sb *SQLBuilder) {
+	if len(b.columns) == 0 {
+		sb.AddError(ErrJsonTableNoColumns)
+		return
+	}
+
+	sb.WriteString("NESTED PATH ")
+	sb.WriteString(pqQuoteLiteral(b.path))
+	if b.pathName != "" {
+		sb.WriteString(" AS ")
+		sb.WriteString(quoteIdentifierIfKeyword(b.pathName))
+	}
+	writeJsonTableColumns(sb, b.columns)
+}
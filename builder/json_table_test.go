@@ -0,0 +1,136 @@
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/builder"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func TestJsonTable(t *testing.T) {
+	t.Run("columns with nested path", func(t *testing.T) {
+		q := qrb.Select(qrb.N("w.id"), qrb.N("jt.*")).
+			From(qrb.N("webhooks")).As("w").
+			From(
+				qrb.JsonTable(qrb.N("w.payload"), qrb.String("$.items[*]")).
+					Columns(
+						builder.JsonTableOrdinalityColumn("idx"),
+						builder.JsonTableColumn("id", "int").Path("$.id"),
+						builder.JsonTableColumn("price", "numeric(10,2)").Path("$.price").
+							OnEmpty(builder.JsonBehaviorDefault(qrb.Int(0))).
+							OnError(builder.JsonBehaviorNull),
+						builder.JsonTableColumn("meta", "jsonb").FormatJson().Path("$.meta").WithConditionalWrapper(),
+						builder.JsonTableExistsColumn("has_discount", "boolean").Path("$.discount"),
+						builder.JsonTableNestedPath("$.tags[*]",
+							builder.JsonTableOrdinalityColumn("tag_idx"),
+							builder.JsonTableColumn("tag", "text").Path("$"),
+						).As("tags"),
+					).
+					OnError(builder.JsonBehaviorError),
+			).As("jt")
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT w.id, jt.* FROM webhooks AS w, JSON_TABLE(w.payload, '$.items[*]' COLUMNS (
+				idx FOR ORDINALITY,
+				id int PATH '$.id',
+				price numeric(10,2) PATH '$.price' DEFAULT 0 ON EMPTY NULL ON ERROR,
+				meta jsonb FORMAT JSON PATH '$.meta' WITH CONDITIONAL WRAPPER,
+				has_discount boolean EXISTS PATH '$.discount',
+				NESTED PATH '$.tags[*]' AS tags COLUMNS (tag_idx FOR ORDINALITY, tag text PATH '$')
+			) ERROR ON ERROR) AS jt`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("path name and passing", func(t *testing.T) {
+		q := qrb.Select(qrb.N("*")).
+			From(
+				qrb.JsonTable(qrb.Arg(`{"items":[]}`).Cast("jsonb"), qrb.String("$.items[*] ? (@.qty > $min)")).
+					PathName("items").
+					Passing(qrb.Arg(5), "min").
+					Columns(builder.JsonTableColumn("qty", "int")),
+			)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT * FROM JSON_TABLE($1::jsonb, '$.items[*] ? (@.qty > $min)' AS items PASSING $2 AS "min" COLUMNS (qty int))`,
+			[]any{`{"items":[]}`, 5},
+			q,
+		)
+	})
+
+	t.Run("keyword names", func(t *testing.T) {
+		q := qrb.Select(qrb.N("*")).
+			From(
+				qrb.JsonTable(qrb.N("payload"), qrb.String("$.items[*]")).
+					PathName("table").
+					Columns(
+						builder.JsonTableOrdinalityColumn("order"),
+						builder.JsonTableColumn("user", "text").Path("$.user"),
+						builder.JsonTableExistsColumn("check", "boolean").Path("$.check"),
+						builder.JsonTableColumn(`"Name"`, "text").Path("$.name"),
+						builder.JsonTableNestedPath("$.tags[*]",
+							builder.JsonTableColumn("tag", "text").Path("$"),
+						).As("from"),
+					),
+			)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT * FROM JSON_TABLE(payload, '$.items[*]' AS "table" COLUMNS (
+				"order" FOR ORDINALITY,
+				"user" text PATH '$.user',
+				"check" boolean EXISTS PATH '$.check',
+				"Name" text PATH '$.name',
+				NESTED PATH '$.tags[*]' AS "from" COLUMNS (tag text PATH '$')
+			))`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("omit quotes with wrapper", func(t *testing.T) {
+		q := qrb.Select(qrb.N("*")).
+			From(
+				qrb.JsonTable(qrb.N("payload"), qrb.String("$.items[*]")).
+					Columns(builder.JsonTableColumn("tags", "text").FormatJson().Path("$.tags").WithWrapper().OmitQuotes()),
+			)
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrJsonTableColumnWrapperAndOmitQuotes)
+	})
+
+	t.Run("lateral join", func(t *testing.T) {
+		q := qrb.Select(qrb.N("jt.id")).
+			From(qrb.N("webhooks")).As("w").
+			LeftJoinLateral(
+				qrb.JsonTable(qrb.N("w.payload"), qrb.String("$.items[*]")).
+					Columns(builder.JsonTableColumn("id", "int").Path("$.id")),
+			).As("jt").On(qrb.Bool(true))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT jt.id FROM webhooks AS w LEFT JOIN LATERAL JSON_TABLE(w.payload, '$.items[*]' COLUMNS (id int PATH '$.id')) AS jt ON true`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("without columns", func(t *testing.T) {
+		q := qrb.Select(qrb.N("*")).From(qrb.JsonTable(qrb.N("doc"), qrb.String("$")))
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrJsonTableNoColumns)
+	})
+
+	t.Run("invalid exists behavior", func(t *testing.T) {
+		q := qrb.Select(qrb.N("*")).From(
+			qrb.JsonTable(qrb.N("doc"), qrb.String("$")).
+				Columns(builder.JsonTableExistsColumn("x", "boolean").OnError(builder.JsonBehaviorNull)),
+		)
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrJsonInvalidBehavior)
+	})
+}
@@ -1,6 +1,10 @@
 package builder
 
-import "strconv"
+import (
+	"strconv"
+	"strings"
+	"time"
+)
 
 func String(s string) Exp {
 	return expStr(s)
@@ -101,10 +105,69 @@ func (e expDefault) WriteSQL(sb *SQLBuilder) {
 }
 
 // Interval builds an interval constant.
-func Interval(spec string) Exp {
-	return expInterval{
-		spec: spec,
+func Interval(spec string) ExpBase {
+	return ExpBase{
+		Exp: expInterval{
+			spec: spec,
+		},
+	}
+}
+
+// IntervalFromDuration builds an interval constant from a duration with microsecond precision (the resolution of PostgreSQL intervals).
+//
+// Example:
+//
+//	IntervalFromDuration(90*time.Minute + 1500*time.Millisecond)
+//	// INTERVAL '1 hours 30 minutes 1.5 seconds'
+func IntervalFromDuration(d time.Duration) ExpBase {
+	d = d.Truncate(time.Microsecond)
+	return IntervalFromFields(IntervalFields{
+		Hours:   int(d / time.Hour),
+		Minutes: int(d % time.Hour / time.Minute),
+		Seconds: (d % time.Minute).Seconds(),
+	})
+}
+
+// IntervalFields are the fields of an interval constant.
+// Unlike a time.Duration, months and days are kept as separate fields, so calendar arithmetic works as expected.
+type IntervalFields struct {
+	Years   int
+	Months  int
+	Weeks   int
+	Days    int
+	Hours   int
+	Minutes int
+	Seconds float64
+}
+
+// String formats the fields as an interval input string. Fields that are zero are omitted.
+func (f IntervalFields) String() string {
+	var parts []string
+	add := func(value int, unit string) {
+		if value != 0 {
+			parts = append(parts, strconv.Itoa(value)+" "+unit)
+		}
+	}
+	add(f.Years, "years")
+	add(f.Months, "months")
+	add(f.Weeks, "weeks")
+	add(f.Days, "days")
+	add(f.Hours, "hours")
+	add(f.Minutes, "minutes")
+	if f.Seconds != 0 || len(parts) == 0 {
+		parts = append(parts, strconv.FormatFloat(f.Seconds, 'f', -1, 64)+" seconds")
 	}
+	return strings.Join(parts, " ")
+}
+
+// IntervalFromFields builds an interval constant from the given fields.
+//
+// Example:
+//
+//	IntervalFromFields(IntervalFields{Months: 1, Days: 15})
+//	// INTERVAL '1 months 15 days'
+func IntervalFromFields(fields IntervalFields) ExpBase {
+	return Interval(fields.String())
 }
 
 type expInterval struct {
@@ -2,8 +2,10 @@ package builder_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/builder"
 	"github.com/networkteam/qrb/internal/testhelper"
 )
 
@@ -66,3 +68,35 @@ func TestString(t *testing.T) {
 		})
 	}
 }
+
+func TestInterval(t *testing.T) {
+	t.Run("from duration", func(t *testing.T) {
+		b := qrb.IntervalFromDuration(90*time.Minute + 1500*time.Millisecond)
+
+		testhelper.AssertSQLWriterEquals(t, "INTERVAL '1 hours 30 minutes 1.5 seconds'", nil, b)
+	})
+
+	t.Run("from negative duration", func(t *testing.T) {
+		b := qrb.IntervalFromDuration(-90 * time.Minute)
+
+		testhelper.AssertSQLWriterEquals(t, "INTERVAL '-1 hours -30 minutes'", nil, b)
+	})
+
+	t.Run("from zero duration", func(t *testing.T) {
+		b := qrb.IntervalFromDuration(0)
+
+		testhelper.AssertSQLWriterEquals(t, "INTERVAL '0 seconds'", nil, b)
+	})
+
+	t.Run("from fields", func(t *testing.T) {
+		b := qrb.N("starts_at").Plus(qrb.IntervalFromFields(builder.IntervalFields{Months: 1, Days: 15}))
+
+		testhelper.AssertSQLWriterEquals(t, "starts_at + INTERVAL '1 months 15 days'", nil, b)
+	})
+
+	t.Run("chained", func(t *testing.T) {
+		b := qrb.Interval("1 day").Plus(qrb.IntervalFromDuration(time.Hour))
+
+		testhelper.AssertSQLWriterEquals(t, "INTERVAL '1 day' + INTERVAL '1 hours'", nil, b)
+	})
+}
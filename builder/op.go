@@ -1,5 +1,12 @@
 package builder
 
+import (
+	"errors"
+	"strings"
+
+	"github.com/networkteam/qrb/types"
+)
+
 type Operator string
 
 const (
@@ -20,22 +27,29 @@ const (
 	opRegexpNotMatch Operator = "!~"
 	// opRegexpINotMatch does not match a string with a POSIX regular expression pattern, case-insensitive.
 	opRegexpINotMatch Operator = "!~*"
+
+	opCollate    Operator = "COLLATE"
+	opAtTimeZone Operator = "AT TIME ZONE"
+	opAtLocal    Operator = "AT LOCAL"
 )
 
 // Mapping of operators to their precedence, higher number means higher precedence.
 // We also include other operators (not using Op) to have a complete mapping.
 // See https://www.postgresql.org/docs/15/sql-syntax-lexical.html#SQL-PRECEDENCE.
 var opPrecedence = map[Operator]int{
-	Operator("."):  7,
-	Operator("::"): 6,
-	// 5: [ ] Array element selection
-	// 4: unary plus / minus
-	opPow:    3,
-	opMult:   2,
-	opDivide: 2,
-	opMod:    2,
-	opPlus:   1,
-	opMinus:  1,
+	Operator("."):  9,
+	Operator("::"): 8,
+	// 7: [ ] Array element selection
+	// 6: unary plus / minus
+	opCollate:    5,
+	opAtTimeZone: 4,
+	opAtLocal:    4,
+	opPow:        3,
+	opMult:       2,
+	opDivide:     2,
+	opMod:        2,
+	opPlus:       1,
+	opMinus:      1,
 	// 0: any other operator (clever use of zero value)
 	Operator("BETWEEN"):              -1,
 	Operator("IN"):                   -1,
@@ -63,6 +77,8 @@ type Precedencer interface {
 }
 
 // Op allows to use arbitrary operators.
+// Operators that are not built-in have the precedence of any other operator.
+// RegisterOperator can be used to add parentheses for the intended grouping of extension operators.
 //
 // Example:
 //
@@ -92,11 +108,7 @@ type opExp struct {
 func (c opExp) IsExp() {}
 
 func (c opExp) WriteSQL(sb *SQLBuilder) {
-	lftNeedsParens := false
-	if lftPrecedence, ok := c.lft.(Precedencer); ok {
-		lftNeedsParens = lftPrecedence.Precedence() < c.Precedence()
-	}
-
+	lftNeedsParens := c.operandNeedsParens(c.lft, false)
 	if lftNeedsParens {
 		sb.WriteRune('(')
 	}
@@ -113,15 +125,7 @@ func (c opExp) WriteSQL(sb *SQLBuilder) {
 		sb.WriteRune(' ')
 	}
 
-	rgtNeedsParens := false
-	if rgtPrecedence, ok := c.rgt.(Precedencer); ok {
-		rgtNeedsParens = rgtPrecedence.Precedence() < c.Precedence()
-		// Special case: if the right expression is an opExp with a different operator and the same precedence (e.g. + / -), we need parentheses.
-		if rgtOpExp, ok := c.rgt.(opExp); ok && rgtOpExp.op != c.op && rgtPrecedence.Precedence() == c.Precedence() {
-			rgtNeedsParens = true
-		}
-	}
-
+	rgtNeedsParens := c.operandNeedsParens(c.rgt, true)
 	if rgtNeedsParens {
 		sb.WriteRune('(')
 	}
@@ -131,6 +135,67 @@ func (c opExp) WriteSQL(sb *SQLBuilder) {
 	}
 }
 
+// operandNeedsParens checks if an operand needs parentheses to be parsed by PostgreSQL as written.
+// A registered operator can only add parentheses (see RegisterOperator).
+func (c opExp) operandNeedsParens(operand Exp, right bool) bool {
+	operandPrecedence, ok := operand.(Precedencer)
+	if !ok {
+		return false
+	}
+	// A prefix operator on the right side is applied to its operand before the enclosing operator.
+	if u, ok := operand.(unaryExp); ok && right && u.prefix != "" && u.suffix == "" && u.Precedence() >= c.Precedence() {
+		return false
+	}
+
+	var operandOp Operator
+	if operandOpExp, ok := operand.(opExp); ok {
+		operandOp = operandOpExp.op
+	}
+
+	// PostgreSQL groups all operators from the left.
+	if groupingNeedsParens(c.op, c.Precedence(), AssocLeft, operandOp, operandPrecedence.Precedence(), right) {
+		return true
+	}
+
+	registered, ok := lookupRegisteredOperator(c.op)
+	if !ok {
+		return false
+	}
+	registeredOperandPrecedence := operandPrecedence.Precedence()
+	if operandRegistered, ok := lookupRegisteredOperator(operandOp); ok {
+		registeredOperandPrecedence = operandRegistered.precedence
+	}
+	return groupingNeedsParens(c.op, registered.precedence, registered.associativity, operandOp, registeredOperandPrecedence, right)
+}
+
+// associativeOperators can be chained on the right side without parentheses, since (a op b) op c = a op (b op c).
+var associativeOperators = map[Operator]bool{
+	opPlus:   true,
+	opMult:   true,
+	opConcat: true,
+}
+
+// groupingNeedsParens decides if an operand of an operator needs parentheses for the given precedence and associativity.
+func groupingNeedsParens(op Operator, precedence int, assoc Associativity, operandOp Operator, operandPrecedence int, right bool) bool {
+	if operandPrecedence != precedence {
+		return operandPrecedence < precedence
+	}
+	switch assoc {
+	case AssocNone:
+		return true
+	case AssocRight:
+		if !right {
+			return true
+		}
+		return operandOp != op
+	default:
+		if !right {
+			return false
+		}
+		return operandOp != op || !associativeOperators[op]
+	}
+}
+
 func (c opExp) Precedence() int {
 	return opPrecedence[c.op]
 }
@@ -171,6 +236,14 @@ func (b ExpBase) IsNotDistinctFrom(rgt Exp) Exp {
 
 // --- String operators
 
+// Concat builds the || operator for strings and arrays.
+//
+//	text || text → text
+//	anyarray || anyarray → anyarray
+//	anyelement || anyarray → anyarray
+//	anyarray || anyelement → anyarray
+//
+// Concatenates the two strings or arrays, or prepends / appends an element to an array.
 func (b ExpBase) Concat(rgt Exp) ExpBase {
 	return b.Op(opConcat, rgt)
 }
@@ -186,7 +259,7 @@ type subscriptExp struct {
 func (s subscriptExp) IsExp() {}
 
 func (s subscriptExp) Precedence() int {
-	return 5 // Array element selection has precedence 5
+	return 7 // Array element selection has precedence 7
 }
 
 func (s subscriptExp) WriteSQL(sb *SQLBuilder) {
@@ -280,6 +353,122 @@ func (b ExpBase) IsNotNull() Exp {
 	}
 }
 
+// IsTrue builds an IS TRUE expression.
+// Unlike = true, it yields false instead of null for null input.
+func (b ExpBase) IsTrue() Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     "IS TRUE",
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// IsNotTrue builds an IS NOT TRUE expression.
+// Unlike <> true, it yields true instead of null for null input.
+func (b ExpBase) IsNotTrue() Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     "IS NOT TRUE",
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// IsFalse builds an IS FALSE expression.
+// Unlike = false, it yields false instead of null for null input.
+func (b ExpBase) IsFalse() Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     "IS FALSE",
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// IsNotFalse builds an IS NOT FALSE expression.
+// Unlike <> false, it yields true instead of null for null input.
+func (b ExpBase) IsNotFalse() Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     "IS NOT FALSE",
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// IsUnknown builds an IS UNKNOWN expression to test if a boolean expression yields null.
+func (b ExpBase) IsUnknown() Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     "IS UNKNOWN",
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// IsNotUnknown builds an IS NOT UNKNOWN expression to test if a boolean expression does not yield null.
+func (b ExpBase) IsNotUnknown() Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     "IS NOT UNKNOWN",
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// IsDocument builds an IS DOCUMENT expression to test if an XML value is a proper XML document.
+func (b ExpBase) IsDocument() Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     "IS DOCUMENT",
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// IsNotDocument builds an IS NOT DOCUMENT expression to test if an XML value is not a proper XML document.
+func (b ExpBase) IsNotDocument() Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     "IS NOT DOCUMENT",
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// NormalizationForm is a Unicode normalization form for ExpBase.IsNormalized.
+type NormalizationForm string
+
+const (
+	NFC  NormalizationForm = "NFC"
+	NFD  NormalizationForm = "NFD"
+	NFKC NormalizationForm = "NFKC"
+	NFKD NormalizationForm = "NFKD"
+)
+
+// IsNormalized builds an IS [form] NORMALIZED expression to check if a text is in the given Unicode normalization form.
+// If no form is given, PostgreSQL defaults to NFC. It panics if more than one form is given.
+func (b ExpBase) IsNormalized(form ...NormalizationForm) Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     normalizedSuffix("IS", form),
+		precedence: opPrecedence["IS"],
+	}
+}
+
+// IsNotNormalized builds an IS NOT [form] NORMALIZED expression to check if a text is not in the given Unicode normalization form.
+// If no form is given, PostgreSQL defaults to NFC. It panics if more than one form is given.
+func (b ExpBase) IsNotNormalized(form ...NormalizationForm) Exp {
+	return unaryExp{
+		exp:        b.Exp,
+		suffix:     normalizedSuffix("IS NOT", form),
+		precedence: opPrecedence["IS"],
+	}
+}
+
+func normalizedSuffix(prefix string, form []NormalizationForm) string {
+	if len(form) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	if len(form) > 0 {
+		return prefix + " " + string(form[0]) + " NORMALIZED"
+	}
+	return prefix + " NORMALIZED"
+}
+
 type unaryExp struct {
 	prefix     string
 	exp        Exp
@@ -370,7 +559,7 @@ func (c junctionExp) WriteSQL(sb *SQLBuilder) {
 	}
 }
 
-func (b ExpBase) Cast(typ string) ExpBase {
+func (b ExpBase) Cast(typ types.Type) ExpBase {
 	exp := opExp{
 		lft:      b.Exp,
 		op:       Operator("::"),
@@ -380,6 +569,83 @@ func (b ExpBase) Cast(typ string) ExpBase {
 	return ExpBase{Exp: exp}
 }
 
+// Collate builds a COLLATE clause to override the collation of the expression (e.g. for sorting or comparison).
+// The collation name is always quoted as an identifier, use ExpBase.CollateSchema for a schema-qualified collation.
+//
+// Example:
+//
+//	N("name").Collate("de-DE-x-icu")
+//	// name COLLATE "de-DE-x-icu"
+func (b ExpBase) Collate(collation string) ExpBase {
+	return b.CollateSchema("", collation)
+}
+
+// CollateSchema builds a COLLATE clause with a collation of the given schema.
+// Schema and collation name are quoted as identifiers.
+//
+// Example:
+//
+//	N("name").CollateSchema("pg_catalog", "default")
+//	// name COLLATE "pg_catalog"."default"
+func (b ExpBase) CollateSchema(schema, collation string) ExpBase {
+	exp := opExp{
+		lft: b.Exp,
+		op:  opCollate,
+		rgt: expCollation{
+			schema: schema,
+			name:   collation,
+		},
+	}
+	return ExpBase{Exp: exp}
+}
+
+type expCollation struct {
+	schema string
+	name   string
+}
+
+func (e expCollation) IsExp() {}
+
+func (e expCollation) WriteSQL(sb *SQLBuilder) {
+	if e.schema != "" {
+		writeQuotedIdentifier(sb, e.schema)
+		sb.WriteRune('.')
+	}
+	writeQuotedIdentifier(sb, e.name)
+}
+
+func writeQuotedIdentifier(sb *SQLBuilder, ident string) {
+	sb.WriteRune('"')
+	sb.WriteString(strings.ReplaceAll(ident, `"`, `""`))
+	sb.WriteRune('"')
+}
+
+// AtTimeZone builds an AT TIME ZONE expression to convert a timestamp or time to the given time zone.
+//
+//	timestamp without time zone AT TIME ZONE zone → timestamp with time zone
+//	timestamp with time zone AT TIME ZONE zone → timestamp without time zone
+//	time with time zone AT TIME ZONE zone → time with time zone
+//
+// Example:
+//
+//	N("created_at").AtTimeZone(Arg("Europe/Berlin"))
+func (b ExpBase) AtTimeZone(zone Exp) ExpBase {
+	return b.Op(opAtTimeZone, zone)
+}
+
+// AtLocal builds an AT LOCAL expression to convert a timestamp or time to the session time zone.
+//
+// Note: requires PostgreSQL 17 or later.
+func (b ExpBase) AtLocal() ExpBase {
+	return ExpBase{
+		Exp: unaryExp{
+			exp:        b.Exp,
+			suffix:     string(opAtLocal),
+			precedence: opPrecedence[opAtLocal],
+		},
+	}
+}
+
 func (b ExpBase) In(selectOrExpressions SelectOrExpressions) Exp {
 	return inExp{
 		lft: b.Exp,
@@ -410,6 +676,12 @@ type inExp struct {
 func (c inExp) IsExp() {}
 
 func (c inExp) WriteSQL(sb *SQLBuilder) {
+	// An empty list is not valid SQL, so we write the constant result instead.
+	if exps, ok := c.rgt.(Expressions); ok && len(exps.exps) == 0 {
+		expBool(c.op == "NOT IN").WriteSQL(sb)
+		return
+	}
+
 	c.lft.WriteSQL(sb)
 	sb.WriteRune(' ')
 	sb.WriteString(c.op)
@@ -417,6 +689,122 @@ func (c inExp) WriteSQL(sb *SQLBuilder) {
 	c.rgt.WriteSQL(sb)
 }
 
+// --- Comparison predicates
+
+// Between builds a BETWEEN predicate (lft BETWEEN lo AND hi).
+func (b ExpBase) Between(lo, hi Exp) Exp {
+	return betweenExp{
+		lft: unwrapExpBase(b.Exp),
+		op:  "BETWEEN",
+		lo:  unwrapExpBase(lo),
+		hi:  unwrapExpBase(hi),
+	}
+}
+
+// NotBetween builds a NOT BETWEEN predicate (lft NOT BETWEEN lo AND hi).
+func (b ExpBase) NotBetween(lo, hi Exp) Exp {
+	return betweenExp{
+		lft: unwrapExpBase(b.Exp),
+		op:  "NOT BETWEEN",
+		lo:  unwrapExpBase(lo),
+		hi:  unwrapExpBase(hi),
+	}
+}
+
+// BetweenSymmetric builds a BETWEEN SYMMETRIC predicate (lft BETWEEN SYMMETRIC lo AND hi).
+// The bounds are swapped automatically if lo is greater than hi.
+func (b ExpBase) BetweenSymmetric(lo, hi Exp) Exp {
+	return betweenExp{
+		lft: unwrapExpBase(b.Exp),
+		op:  "BETWEEN SYMMETRIC",
+		lo:  unwrapExpBase(lo),
+		hi:  unwrapExpBase(hi),
+	}
+}
+
+// NotBetweenSymmetric builds a NOT BETWEEN SYMMETRIC predicate (lft NOT BETWEEN SYMMETRIC lo AND hi).
+func (b ExpBase) NotBetweenSymmetric(lo, hi Exp) Exp {
+	return betweenExp{
+		lft: unwrapExpBase(b.Exp),
+		op:  "NOT BETWEEN SYMMETRIC",
+		lo:  unwrapExpBase(lo),
+		hi:  unwrapExpBase(hi),
+	}
+}
+
+// unwrapExpBase returns the expression wrapped by an ExpBase, so the precedence of operators can be checked.
+func unwrapExpBase(exp Exp) Exp {
+	for {
+		expBase, ok := exp.(ExpBase)
+		if !ok {
+			return exp
+		}
+		exp = expBase.Exp
+	}
+}
+
+type betweenExp struct {
+	lft Exp
+	op  string
+	lo  Exp
+	hi  Exp
+}
+
+func (c betweenExp) IsExp() {}
+
+func (c betweenExp) Precedence() int {
+	return opPrecedence["BETWEEN"]
+}
+
+func (c betweenExp) WriteSQL(sb *SQLBuilder) {
+	c.writeOperand(sb, c.lft)
+	sb.WriteRune(' ')
+	sb.WriteString(c.op)
+	sb.WriteRune(' ')
+	c.writeOperand(sb, c.lo)
+	sb.WriteString(" AND ")
+	c.writeOperand(sb, c.hi)
+}
+
+func (c betweenExp) writeOperand(sb *SQLBuilder, exp Exp) {
+	needsParens := false
+	if expPrecedence, ok := exp.(Precedencer); ok {
+		// Nested BETWEEN operands are ambiguous because of the AND keyword, so also wrap operators with the same precedence.
+		needsParens = expPrecedence.Precedence() <= c.Precedence()
+	}
+
+	if needsParens {
+		sb.WriteRune('(')
+	}
+	exp.WriteSQL(sb)
+	if needsParens {
+		sb.WriteRune(')')
+	}
+}
+
+// InArgs builds a condition that checks if exp is contained in the given values.
+// The values are bound as a single array argument (exp = ANY ($1)) instead of one placeholder per value,
+// so the number of placeholders does not depend on the number of values.
+// If values is empty, the constant false is written.
+//
+// Note: the driver must support arrays as arguments (e.g. pgx does, for lib/pq wrap the values with pq.Array and use Any).
+func InArgs[T any](exp Exp, values []T) Exp {
+	if len(values) == 0 {
+		return expBool(false)
+	}
+	return ExpBase{Exp: unwrapExpBase(exp)}.Op(opEqual, Any(Arg(values)))
+}
+
+// NotInArgs builds a condition that checks if exp is not contained in the given values.
+// The values are bound as a single array argument (exp <> ALL ($1)).
+// If values is empty, the constant true is written.
+func NotInArgs[T any](exp Exp, values []T) Exp {
+	if len(values) == 0 {
+		return expBool(true)
+	}
+	return ExpBase{Exp: unwrapExpBase(exp)}.Op(opNotEqual, All(Arg(values)))
+}
+
 func Exists(subquery SelectExp) Exp {
 	return existsExp{
 		subquery: subquery,
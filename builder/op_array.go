@@ -0,0 +1,48 @@
+package builder
+
+// Array operators
+
+const (
+	opOverlaps Operator = "&&"
+)
+
+// Overlaps builds the && operator for arrays.
+//
+//	anyarray && anyarray → boolean
+//
+// Do the arrays overlap, that is, have any elements in common?
+// For time periods with the SQL OVERLAPS operator, use RowExp.OverlapsPeriod.
+//
+// Example:
+//
+//	N("tags").Overlaps(Arg([]string{"go", "sql"}).Cast("text[]"))
+//	// tags && $1::text[]
+func (b ExpBase) Overlaps(rgt Exp) ExpBase {
+	return b.Op(opOverlaps, rgt)
+}
+
+// OpAny builds a comparison of the expression with each element of the array using the given operator.
+// The result is true if any comparison yields true.
+//
+//	expression operator ANY (array expression)
+//
+// Example:
+//
+//	N("title").OpAny("ILIKE", Arg([]string{"%foo%", "%bar%"}))
+//	// title ILIKE ANY ($1)
+func (b ExpBase) OpAny(op Operator, arr Exp) ExpBase {
+	return b.Op(op, Any(arr))
+}
+
+// OpAll builds a comparison of the expression with each element of the array using the given operator.
+// The result is true if all comparisons yield true (including the case where the array has zero elements).
+//
+//	expression operator ALL (array expression)
+//
+// Example:
+//
+//	N("price").OpAll(">", Arg([]int{10, 20}))
+//	// price > ALL ($1)
+func (b ExpBase) OpAll(op Operator, arr Exp) ExpBase {
+	return b.Op(op, All(arr))
+}
@@ -69,10 +69,26 @@ func (b ExpBase) JsonExtractPathText(rgt Exp) ExpBase {
 	return b.Op(opExtractPathText, rgt)
 }
 
+// Contains builds the @> operator for jsonb, arrays and ranges.
+//
+//	jsonb @> jsonb → boolean
+//	anyarray @> anyarray → boolean
+//	anyrange @> anyrange → boolean
+//	anyrange @> anyelement → boolean
+//
+// Does the first value contain the second?
 func (b ExpBase) Contains(rgt Exp) ExpBase {
 	return b.Op(opContains, rgt)
 }
 
+// ContainedBy builds the <@ operator for jsonb, arrays and ranges.
+//
+//	jsonb <@ jsonb → boolean
+//	anyarray <@ anyarray → boolean
+//	anyrange <@ anyrange → boolean
+//	anyelement <@ anyrange → boolean
+//
+// Is the first value contained in the second?
 func (b ExpBase) ContainedBy(rgt Exp) ExpBase {
 	return b.Op(opContainedBy, rgt)
 }
@@ -9,6 +9,10 @@ const (
 	opMult   Operator = "*"
 	opMod    Operator = "%"
 	opPow    Operator = "^"
+
+	opBitAnd Operator = "&"
+	opBitOr  Operator = "|"
+	opBitXor Operator = "#"
 )
 
 // Plus builds the + operator (addition) for numeric types.
@@ -27,7 +31,7 @@ func Neg(exp Exp) ExpBase {
 		Exp: unaryExp{
 			prefix:     "-",
 			exp:        exp,
-			precedence: 4, // see opPrecedence for unary minus
+			precedence: 6, // see opPrecedence for unary minus
 		},
 	}
 }
@@ -52,3 +56,58 @@ func (b ExpBase) Mod(rgt Exp) ExpBase {
 func (b ExpBase) Pow(rgt Exp) ExpBase {
 	return b.Op(opPow, rgt)
 }
+
+// SquareRoot builds the |/ prefix operator (square root).
+//
+//	|/ double precision → double precision
+func SquareRoot(exp Exp) ExpBase {
+	return prefixOp("|/", exp)
+}
+
+// CubeRoot builds the ||/ prefix operator (cube root).
+//
+//	||/ double precision → double precision
+func CubeRoot(exp Exp) ExpBase {
+	return prefixOp("||/", exp)
+}
+
+// AbsoluteValue builds the @ prefix operator (absolute value).
+//
+//	@ numeric_type → numeric_type
+func AbsoluteValue(exp Exp) ExpBase {
+	return prefixOp("@", exp)
+}
+
+// Bitwise operators
+
+// BitAnd builds the & operator (bitwise AND) for integral types and bit strings.
+func (b ExpBase) BitAnd(rgt Exp) ExpBase {
+	return b.Op(opBitAnd, rgt)
+}
+
+// BitOr builds the | operator (bitwise OR) for integral types and bit strings.
+func (b ExpBase) BitOr(rgt Exp) ExpBase {
+	return b.Op(opBitOr, rgt)
+}
+
+// BitXor builds the # operator (bitwise exclusive OR) for integral types and bit strings.
+func (b ExpBase) BitXor(rgt Exp) ExpBase {
+	return b.Op(opBitXor, rgt)
+}
+
+// ShiftLeft builds the << operator (bitwise shift left) for integral types and bit strings.
+// For ranges, the same operator is available as ExpBase.StrictlyLeftOf.
+func (b ExpBase) ShiftLeft(rgt Exp) ExpBase {
+	return b.Op(opStrictlyLeftOf, rgt)
+}
+
+// ShiftRight builds the >> operator (bitwise shift right) for integral types and bit strings.
+// For ranges, the same operator is available as ExpBase.StrictlyRightOf.
+func (b ExpBase) ShiftRight(rgt Exp) ExpBase {
+	return b.Op(opStrictlyRightOf, rgt)
+}
+
+// BitNot builds the ~ prefix operator (bitwise NOT) for integral types and bit strings.
+func BitNot(exp Exp) ExpBase {
+	return prefixOp("~", exp)
+}
@@ -0,0 +1,60 @@
+package builder
+
+// Range operators
+//
+// The containment operators @> and <@ are available as ExpBase.Contains and ExpBase.ContainedBy,
+// overlapping ranges can be checked with ExpBase.Overlaps (&&).
+// Union, intersection and difference of ranges use ExpBase.Plus (+), ExpBase.Mult (*) and ExpBase.Minus (-).
+
+const (
+	opStrictlyLeftOf       Operator = "<<"
+	opStrictlyRightOf      Operator = ">>"
+	opDoesNotExtendRightOf Operator = "&<"
+	opDoesNotExtendLeftOf  Operator = "&>"
+	opAdjacentTo           Operator = "-|-"
+)
+
+// StrictlyLeftOf builds the << operator for ranges and multiranges.
+//
+//	anyrange << anyrange → boolean
+//
+// Is the first range strictly left of the second?
+func (b ExpBase) StrictlyLeftOf(rgt Exp) ExpBase {
+	return b.Op(opStrictlyLeftOf, rgt)
+}
+
+// StrictlyRightOf builds the >> operator for ranges and multiranges.
+//
+//	anyrange >> anyrange → boolean
+//
+// Is the first range strictly right of the second?
+func (b ExpBase) StrictlyRightOf(rgt Exp) ExpBase {
+	return b.Op(opStrictlyRightOf, rgt)
+}
+
+// DoesNotExtendRightOf builds the &< operator for ranges and multiranges.
+//
+//	anyrange &< anyrange → boolean
+//
+// Does the first range not extend to the right of the second?
+func (b ExpBase) DoesNotExtendRightOf(rgt Exp) ExpBase {
+	return b.Op(opDoesNotExtendRightOf, rgt)
+}
+
+// DoesNotExtendLeftOf builds the &> operator for ranges and multiranges.
+//
+//	anyrange &> anyrange → boolean
+//
+// Does the first range not extend to the left of the second?
+func (b ExpBase) DoesNotExtendLeftOf(rgt Exp) ExpBase {
+	return b.Op(opDoesNotExtendLeftOf, rgt)
+}
+
+// AdjacentTo builds the -|- operator for ranges and multiranges.
+//
+//	anyrange -|- anyrange → boolean
+//
+// Are the ranges adjacent?
+func (b ExpBase) AdjacentTo(rgt Exp) ExpBase {
+	return b.Op(opAdjacentTo, rgt)
+}
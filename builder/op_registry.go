@@ -0,0 +1,86 @@
+package builder
+
+import (
+	"fmt"
+	"sync"
+)
+
+// Associativity defines how a registered operator is meant to group with operators of the same precedence.
+type Associativity int
+
+const (
+	// AssocLeft groups operators of the same precedence from the left: a op b op c = (a op b) op c.
+	// This is how PostgreSQL treats all operators.
+	AssocLeft Associativity = iota
+	// AssocRight groups operators of the same precedence from the right: a op b op c = a op (b op c).
+	AssocRight
+	// AssocNone does not allow chaining operators of the same precedence, so operands are always parenthesized.
+	AssocNone
+)
+
+// Precedence levels of the built-in operators that can be used when registering operators.
+// See https://www.postgresql.org/docs/current/sql-syntax-lexical.html#SQL-PRECEDENCE.
+const (
+	PrecedenceTypecast       = 8
+	PrecedenceExponent       = 3
+	PrecedenceMultiplication = 2
+	PrecedenceAddition       = 1
+	// PrecedenceOther is the precedence of any other operator, which PostgreSQL uses for all user-defined operators.
+	PrecedenceOther      = 0
+	PrecedenceRange      = -1
+	PrecedenceComparison = -2
+	PrecedenceIs         = -3
+)
+
+type registeredOperator struct {
+	precedence    int
+	associativity Associativity
+}
+
+var (
+	registeredOperatorsMx sync.RWMutex
+	registeredOperators   = make(map[Operator]registeredOperator)
+)
+
+// RegisterOperator registers the intended precedence and associativity of an operator (e.g. from an extension like PostGIS or pg_trgm).
+//
+// PostgreSQL parses every operator that is not built-in with the precedence of any other operator (PrecedenceOther) and groups it from the left,
+// regardless of how the operator is defined. So a registration can only add parentheses to make the intended grouping explicit,
+// it never removes parentheses that PostgreSQL needs to parse the expression as built.
+//
+// Note that PostgreSQL determines the precedence of an operator by its name, so operators named like a built-in operator
+// (e.g. % of pg_trgm) already have the correct precedence. Registering a built-in operator panics.
+// Operators should be registered once during initialization, e.g. in an init function.
+//
+// Example:
+//
+//	builder.RegisterOperator("<->", builder.PrecedenceMultiplication, builder.AssocLeft)
+//	N("a").Plus(N("b")).Op("<->", N("c"))
+//	// (a + b) <-> c
+func RegisterOperator(op Operator, precedence int, associativity Associativity) {
+	if _, ok := opPrecedence[op]; ok {
+		panic(fmt.Sprintf("builder: cannot register built-in operator %s", op))
+	}
+
+	registeredOperatorsMx.Lock()
+	defer registeredOperatorsMx.Unlock()
+	registeredOperators[op] = registeredOperator{
+		precedence:    precedence,
+		associativity: associativity,
+	}
+}
+
+// UnregisterOperator removes the registration of an operator.
+func UnregisterOperator(op Operator) {
+	registeredOperatorsMx.Lock()
+	defer registeredOperatorsMx.Unlock()
+	delete(registeredOperators, op)
+}
+
+// lookupRegisteredOperator returns the registration of an operator.
+func lookupRegisteredOperator(op Operator) (registeredOperator, bool) {
+	registeredOperatorsMx.RLock()
+	defer registeredOperatorsMx.RUnlock()
+	registered, ok := registeredOperators[op]
+	return registered, ok
+}
@@ -0,0 +1,87 @@
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/builder"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func registerOperator(t *testing.T, op builder.Operator, precedence int, associativity builder.Associativity) {
+	t.Helper()
+	builder.RegisterOperator(op, precedence, associativity)
+	t.Cleanup(func() {
+		builder.UnregisterOperator(op)
+	})
+}
+
+func TestRegisterOperator(t *testing.T) {
+	t.Run("unregistered operator has other precedence", func(t *testing.T) {
+		b := qrb.N("a").Plus(qrb.N("b")).Op("|=|", qrb.N("c")).Eq(qrb.Int(0))
+
+		testhelper.AssertSQLWriterEquals(t, `a + b |=| c = 0`, nil, b)
+	})
+
+	t.Run("unregistered operator nested on the right", func(t *testing.T) {
+		b := qrb.N("a").Op("->", qrb.N("b").Op("->", qrb.N("c")))
+
+		testhelper.AssertSQLWriterEquals(t, `a -> (b -> c)`, nil, b)
+	})
+
+	t.Run("registered precedence adds parentheses", func(t *testing.T) {
+		registerOperator(t, "~>>", builder.PrecedenceMultiplication, builder.AssocLeft)
+
+		b := qrb.N("a").Plus(qrb.N("b")).Op("~>>", qrb.N("c").Mult(qrb.N("d")))
+
+		testhelper.AssertSQLWriterEquals(t, `(a + b) ~>> (c * d)`, nil, b)
+	})
+
+	t.Run("registered precedence does not remove parentheses", func(t *testing.T) {
+		registerOperator(t, "~>>", builder.PrecedenceMultiplication, builder.AssocLeft)
+
+		b := qrb.N("a").Op("~>>", qrb.N("b")).Plus(qrb.N("c")).Mult(qrb.N("d").Op("~>>", qrb.N("e")))
+
+		testhelper.AssertSQLWriterEquals(t, `((a ~>> b) + c) * (d ~>> e)`, nil, b)
+	})
+
+	t.Run("right associative", func(t *testing.T) {
+		registerOperator(t, "^^^", builder.PrecedenceExponent, builder.AssocRight)
+
+		b := qrb.N("a").Op("^^^", qrb.N("b").Op("^^^", qrb.N("c")))
+		testhelper.AssertSQLWriterEquals(t, `a ^^^ (b ^^^ c)`, nil, b)
+
+		b = qrb.N("a").Op("^^^", qrb.N("b")).Op("^^^", qrb.N("c"))
+		testhelper.AssertSQLWriterEquals(t, `(a ^^^ b) ^^^ c`, nil, b)
+	})
+
+	t.Run("non associative", func(t *testing.T) {
+		registerOperator(t, "<=>>", builder.PrecedenceComparison, builder.AssocNone)
+
+		b := qrb.N("a").Op("<=>>", qrb.N("b")).Op("<=>>", qrb.N("c").Op("<=>>", qrb.N("d")))
+
+		testhelper.AssertSQLWriterEquals(t, `(a <=>> b) <=>> (c <=>> d)`, nil, b)
+	})
+
+	t.Run("lower registered precedence keeps PostgreSQL grouping", func(t *testing.T) {
+		registerOperator(t, "<~>", builder.PrecedenceComparison, builder.AssocLeft)
+
+		b := qrb.N("a").Op("<~>", qrb.N("b")).Plus(qrb.N("c"))
+
+		testhelper.AssertSQLWriterEquals(t, `(a <~> b) + c`, nil, b)
+	})
+
+	t.Run("unregistered after cleanup", func(t *testing.T) {
+		b := qrb.N("a").Op("~>>", qrb.N("b")).Op("~>>", qrb.N("c"))
+
+		testhelper.AssertSQLWriterEquals(t, `a ~>> b ~>> c`, nil, b)
+	})
+
+	t.Run("built-in operator", func(t *testing.T) {
+		require.Panics(t, func() {
+			builder.RegisterOperator("%", builder.PrecedenceOther, builder.AssocLeft)
+		})
+	})
+}
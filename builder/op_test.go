@@ -3,6 +3,8 @@ package builder_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/networkteam/qrb"
 	"github.com/networkteam/qrb/builder"
 	"github.com/networkteam/qrb/internal/testhelper"
@@ -110,6 +112,26 @@ func TestOp(t *testing.T) {
 				b,
 			)
 		})
+		t.Run("minus and grouped minus", func(t *testing.T) {
+			b := qrb.N("a").Minus(qrb.N("b").Minus(qrb.N("c")))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"a - (b - c)",
+				nil,
+				b,
+			)
+		})
+		t.Run("divide and grouped divide", func(t *testing.T) {
+			b := qrb.N("a").Divide(qrb.N("b").Divide(qrb.N("c")))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"a / (b / c)",
+				nil,
+				b,
+			)
+		})
 		t.Run("plus times plus", func(t *testing.T) {
 			e1 := qrb.N("a").Plus(qrb.N("b"))
 			e2 := qrb.N("c").Plus(qrb.N("d"))
@@ -262,4 +284,292 @@ func TestOp(t *testing.T) {
 			)
 		})
 	})
+
+	t.Run("between", func(t *testing.T) {
+		t.Run("between", func(t *testing.T) {
+			b := qrb.N("created_at").Between(qrb.Arg("2023-01-01"), qrb.Arg("2023-12-31"))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"created_at BETWEEN $1 AND $2",
+				[]any{"2023-01-01", "2023-12-31"},
+				b,
+			)
+		})
+
+		t.Run("not between", func(t *testing.T) {
+			b := qrb.N("price").NotBetween(qrb.Int(10), qrb.Int(20))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"price NOT BETWEEN 10 AND 20",
+				nil,
+				b,
+			)
+		})
+
+		t.Run("between symmetric", func(t *testing.T) {
+			b := qrb.N("price").BetweenSymmetric(qrb.Int(20), qrb.Int(10))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"price BETWEEN SYMMETRIC 20 AND 10",
+				nil,
+				b,
+			)
+		})
+
+		t.Run("not between symmetric", func(t *testing.T) {
+			b := qrb.N("price").NotBetweenSymmetric(qrb.Int(20), qrb.Int(10))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"price NOT BETWEEN SYMMETRIC 20 AND 10",
+				nil,
+				b,
+			)
+		})
+
+		t.Run("arithmetic operands", func(t *testing.T) {
+			b := qrb.N("a").Plus(qrb.Int(1)).Between(qrb.N("b").Minus(qrb.Int(1)), qrb.N("c").Mult(qrb.Int(2)))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"a + 1 BETWEEN b - 1 AND c * 2",
+				nil,
+				b,
+			)
+		})
+
+		t.Run("comparison operands", func(t *testing.T) {
+			b := builder.ExpBase{Exp: qrb.N("a").Eq(qrb.N("b"))}.Between(qrb.Bool(false), qrb.N("c").Gt(qrb.Int(1)))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"(a = b) BETWEEN false AND (c > 1)",
+				nil,
+				b,
+			)
+		})
+
+		t.Run("nested between", func(t *testing.T) {
+			b := builder.ExpBase{Exp: qrb.N("a").Between(qrb.Int(1), qrb.Int(2))}.Between(qrb.Bool(false), qrb.Bool(true))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"(a BETWEEN 1 AND 2) BETWEEN false AND true",
+				nil,
+				b,
+			)
+		})
+
+		t.Run("in comparison", func(t *testing.T) {
+			b := builder.ExpBase{Exp: qrb.N("a").Between(qrb.Int(1), qrb.Int(2))}.Eq(qrb.Bool(true))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"a BETWEEN 1 AND 2 = true",
+				nil,
+				b,
+			)
+		})
+
+		t.Run("with arithmetic on between", func(t *testing.T) {
+			b := builder.ExpBase{Exp: qrb.N("flag")}.Concat(builder.ExpBase{Exp: qrb.N("a").Between(qrb.Int(1), qrb.Int(2))})
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"flag || (a BETWEEN 1 AND 2)",
+				nil,
+				b,
+			)
+		})
+
+		t.Run("negated", func(t *testing.T) {
+			b := qrb.Not(qrb.N("a").Between(qrb.Int(1), qrb.Int(2)))
+
+			testhelper.AssertSQLWriterEquals(
+				t,
+				"NOT a BETWEEN 1 AND 2",
+				nil,
+				b,
+			)
+		})
+	})
+
+	t.Run("boolean tests", func(t *testing.T) {
+		tt := []struct {
+			name        string
+			exp         builder.Exp
+			expectedSQL string
+		}{
+			{"is true", qrb.N("active").IsTrue(), "active IS TRUE"},
+			{"is not true", qrb.N("active").IsNotTrue(), "active IS NOT TRUE"},
+			{"is false", qrb.N("active").IsFalse(), "active IS FALSE"},
+			{"is not false", qrb.N("active").IsNotFalse(), "active IS NOT FALSE"},
+			{"is unknown", qrb.N("active").IsUnknown(), "active IS UNKNOWN"},
+			{"is not unknown", qrb.N("active").IsNotUnknown(), "active IS NOT UNKNOWN"},
+			{"is document", qrb.N("data").IsDocument(), "data IS DOCUMENT"},
+			{"is not document", qrb.N("data").IsNotDocument(), "data IS NOT DOCUMENT"},
+			{"is normalized", qrb.N("name").IsNormalized(), "name IS NORMALIZED"},
+			{"is normalized with form", qrb.N("name").IsNormalized(builder.NFKC), "name IS NFKC NORMALIZED"},
+			{"is not normalized", qrb.N("name").IsNotNormalized(), "name IS NOT NORMALIZED"},
+			{"is not normalized with form", qrb.N("name").IsNotNormalized(builder.NFD), "name IS NOT NFD NORMALIZED"},
+			{"comparison is true", qrb.N("a").Gt(qrb.N("b")).(builder.ExpBase).IsTrue(), "a > b IS TRUE"},
+			{"junction is not false", builder.ExpBase{Exp: qrb.Or(qrb.N("a"), qrb.N("b"))}.IsNotFalse(), "(a OR b) IS NOT FALSE"},
+		}
+		for _, tc := range tt {
+			t.Run(tc.name, func(t *testing.T) {
+				testhelper.AssertSQLWriterEquals(t, tc.expectedSQL, nil, tc.exp)
+			})
+		}
+
+		t.Run("is normalized with too many forms", func(t *testing.T) {
+			require.Panics(t, func() {
+				qrb.N("name").IsNormalized(builder.NFC, builder.NFKC)
+			})
+			require.Panics(t, func() {
+				qrb.N("name").IsNotNormalized(builder.NFC, builder.NFKC)
+			})
+		})
+	})
+
+	t.Run("collate", func(t *testing.T) {
+		t.Run("order by", func(t *testing.T) {
+			q := qrb.Select(qrb.N("name")).From(qrb.N("users")).OrderBy(qrb.N("name").Collate("de-DE-x-icu"))
+
+			testhelper.AssertSQLWriterEquals(t, `SELECT name FROM users ORDER BY name COLLATE "de-DE-x-icu"`, nil, q)
+		})
+
+		t.Run("qualified collation", func(t *testing.T) {
+			b := qrb.N("a").CollateSchema("pg_catalog", "default").Lt(qrb.String("foo"))
+
+			testhelper.AssertSQLWriterEquals(t, `a COLLATE "pg_catalog"."default" < 'foo'`, nil, b)
+		})
+
+		t.Run("dotted collation name", func(t *testing.T) {
+			b := qrb.N("name").Collate("en_US.utf8")
+
+			testhelper.AssertSQLWriterEquals(t, `name COLLATE "en_US.utf8"`, nil, b)
+		})
+
+		t.Run("collation name with quotes", func(t *testing.T) {
+			b := qrb.N("name").Collate(`my"collation`)
+
+			testhelper.AssertSQLWriterEquals(t, `name COLLATE "my""collation"`, nil, b)
+		})
+
+		t.Run("concat", func(t *testing.T) {
+			b := qrb.N("a").Concat(qrb.N("b")).Collate("C")
+
+			testhelper.AssertSQLWriterEquals(t, `(a || b) COLLATE "C"`, nil, b)
+		})
+
+		t.Run("cast", func(t *testing.T) {
+			b := qrb.Arg("foo").Cast("text").Collate("C")
+
+			testhelper.AssertSQLWriterEquals(t, `$1::text COLLATE "C"`, []any{"foo"}, b)
+		})
+	})
+
+	t.Run("at time zone", func(t *testing.T) {
+		t.Run("column", func(t *testing.T) {
+			b := qrb.N("created_at").AtTimeZone(qrb.Arg("Europe/Berlin"))
+
+			testhelper.AssertSQLWriterEquals(t, `created_at AT TIME ZONE $1`, []any{"Europe/Berlin"}, b)
+		})
+
+		t.Run("with arithmetic", func(t *testing.T) {
+			b := qrb.N("created_at").Plus(qrb.Interval("1 day")).AtTimeZone(qrb.String("UTC")).Minus(qrb.N("offset"))
+
+			testhelper.AssertSQLWriterEquals(t, `(created_at + INTERVAL '1 day') AT TIME ZONE 'UTC' - "offset"`, nil, b)
+		})
+
+		t.Run("chained", func(t *testing.T) {
+			b := qrb.N("ts").AtTimeZone(qrb.String("UTC")).AtTimeZone(qrb.String("Europe/Berlin")).Cast("date")
+
+			testhelper.AssertSQLWriterEquals(t, `(ts AT TIME ZONE 'UTC' AT TIME ZONE 'Europe/Berlin')::date`, nil, b)
+		})
+
+		t.Run("at local", func(t *testing.T) {
+			b := qrb.N("created_at").AtLocal()
+
+			testhelper.AssertSQLWriterEquals(t, `created_at AT LOCAL`, nil, b)
+		})
+	})
+
+	t.Run("bitwise", func(t *testing.T) {
+		t.Run("and with shift", func(t *testing.T) {
+			b := qrb.N("flags").BitAnd(qrb.N("mask").ShiftLeft(qrb.N("bit")))
+
+			testhelper.AssertSQLWriterEquals(t, `flags & (mask << bit)`, nil, b)
+		})
+
+		t.Run("or and xor", func(t *testing.T) {
+			b := qrb.N("a").BitOr(qrb.N("b")).BitXor(qrb.N("c")).ShiftRight(qrb.Int(2))
+
+			testhelper.AssertSQLWriterEquals(t, `a | b # c >> 2`, nil, b)
+		})
+
+		t.Run("not", func(t *testing.T) {
+			b := qrb.N("mask").BitAnd(builder.BitNot(qrb.N("a").BitOr(qrb.N("b"))))
+
+			testhelper.AssertSQLWriterEquals(t, `mask & ~ (a | b)`, nil, b)
+		})
+
+		t.Run("in comparison", func(t *testing.T) {
+			b := qrb.N("flags").BitAnd(qrb.Int(4)).Neq(qrb.Int(0))
+
+			testhelper.AssertSQLWriterEquals(t, `flags & 4 <> 0`, nil, b)
+		})
+	})
+
+	t.Run("math prefix operators", func(t *testing.T) {
+		b := builder.SquareRoot(qrb.N("a").Pow(qrb.Int(2)).Plus(qrb.N("b").Pow(qrb.Int(2)))).
+			Plus(builder.CubeRoot(qrb.N("v"))).
+			Plus(builder.AbsoluteValue(qrb.N("delta")))
+
+		testhelper.AssertSQLWriterEquals(t, `(|/ (a ^ 2 + b ^ 2)) + (||/ v) + (@ delta)`, nil, b)
+	})
+
+	t.Run("array", func(t *testing.T) {
+		t.Run("overlaps", func(t *testing.T) {
+			b := qrb.N("tags").Overlaps(qrb.Arg([]string{"go", "sql"}).Cast("text[]"))
+
+			testhelper.AssertSQLWriterEquals(t, `tags && $1::text[]`, []any{[]string{"go", "sql"}}, b)
+		})
+
+		t.Run("overlaps in condition", func(t *testing.T) {
+			b := qrb.And(
+				qrb.N("tags").Overlaps(qrb.Array(qrb.String("go"))),
+				qrb.N("published").Eq(qrb.Bool(true)),
+			)
+
+			testhelper.AssertSQLWriterEquals(t, `tags && ARRAY['go'] AND published = true`, nil, b)
+		})
+
+		t.Run("concat", func(t *testing.T) {
+			b := qrb.N("tags").Concat(qrb.Arg([]string{"new"}).Cast("text[]"))
+
+			testhelper.AssertSQLWriterEquals(t, `tags || $1::text[]`, []any{[]string{"new"}}, b)
+		})
+
+		t.Run("op any", func(t *testing.T) {
+			b := qrb.N("title").OpAny("ILIKE", qrb.Arg([]string{"%foo%", "%bar%"}))
+
+			testhelper.AssertSQLWriterEquals(t, `title ILIKE ANY ($1)`, []any{[]string{"%foo%", "%bar%"}}, b)
+		})
+
+		t.Run("op all with subquery", func(t *testing.T) {
+			b := qrb.N("price").OpAll(">", qrb.Select(qrb.N("price")).From(qrb.N("competitors")))
+
+			testhelper.AssertSQLWriterEquals(t, `price > ALL (SELECT price FROM competitors)`, nil, b)
+		})
+
+		t.Run("op any with arithmetic", func(t *testing.T) {
+			b := qrb.N("a").Plus(qrb.N("b")).OpAny(builder.Operator("="), qrb.N("vals"))
+
+			testhelper.AssertSQLWriterEquals(t, `a + b = ANY (vals)`, nil, b)
+		})
+	})
 }
@@ -0,0 +1,104 @@
+package builder
+
+import "strconv"
+
+// Text search operators
+
+const (
+	opTextSearchMatch Operator = "@@"
+	opTsqueryAnd      Operator = "&&"
+	opTsqueryOr       Operator = "||"
+	opFollowedBy      Operator = "<->"
+)
+
+// Matches builds the @@ operator for text search.
+//
+//	tsvector @@ tsquery → boolean
+//	tsquery @@ tsvector → boolean
+//	text @@ tsquery → boolean
+//
+// Does the tsvector match the tsquery?
+//
+// Example:
+//
+//	N("search_vector").Matches(fn.WebsearchToTsquery(String("english"), Arg(q)))
+//	// search_vector @@ websearch_to_tsquery('english', $1)
+func (b ExpBase) Matches(rgt Exp) ExpBase {
+	return b.Op(opTextSearchMatch, rgt)
+}
+
+// TsqueryAnd builds the && operator for tsquery.
+//
+//	tsquery && tsquery → tsquery
+//
+// ANDs two tsquerys together, producing a query that matches documents that match both input queries.
+func (b ExpBase) TsqueryAnd(rgt Exp) ExpBase {
+	return b.Op(opTsqueryAnd, rgt)
+}
+
+// TsqueryOr builds the || operator for tsquery.
+//
+//	tsquery || tsquery → tsquery
+//
+// ORs two tsquerys together, producing a query that matches documents that match either input query.
+func (b ExpBase) TsqueryOr(rgt Exp) ExpBase {
+	return b.Op(opTsqueryOr, rgt)
+}
+
+// TsqueryNot builds the !! operator for tsquery.
+//
+//	!! tsquery → tsquery
+//
+// Negates a tsquery, producing a query that matches documents that do not match the input query.
+func (b ExpBase) TsqueryNot() ExpBase {
+	return prefixOp("!!", b.Exp)
+}
+
+// FollowedBy builds the <-> operator for tsquery.
+//
+//	tsquery <-> tsquery → tsquery
+//
+// Constructs a phrase query, which matches if the two input queries match at successive lexemes.
+func (b ExpBase) FollowedBy(rgt Exp) ExpBase {
+	return b.Op(opFollowedBy, rgt)
+}
+
+// FollowedByDistance builds the <N> operator for tsquery.
+//
+//	tsquery <N> tsquery → tsquery
+//
+// Constructs a phrase query, which matches if the second query matches exactly distance lexemes after the first.
+func (b ExpBase) FollowedByDistance(distance int, rgt Exp) ExpBase {
+	return b.Op(Operator("<"+strconv.Itoa(distance)+">"), rgt)
+}
+
+// prefixOp builds a prefix operator with the precedence of any other operator.
+func prefixOp(prefix string, exp Exp) ExpBase {
+	// Unwrap any ExpBase.
+	if expIsExpBase, ok := exp.(ExpBase); ok {
+		exp = expIsExpBase.Exp
+	}
+	// The prefix operator binds as loose as any other operator, so operator expressions always get parentheses for clarity.
+	if _, ok := exp.(Precedencer); ok {
+		exp = parensExp{exp: exp}
+	}
+	return ExpBase{
+		Exp: unaryExp{
+			prefix: prefix,
+			exp:    exp,
+		},
+	}
+}
+
+// parensExp always wraps the expression in parentheses.
+type parensExp struct {
+	exp Exp
+}
+
+func (p parensExp) IsExp() {}
+
+func (p parensExp) WriteSQL(sb *SQLBuilder) {
+	sb.WriteRune('(')
+	p.exp.WriteSQL(sb)
+	sb.WriteRune(')')
+}
@@ -0,0 +1,106 @@
+package builder
+
+import "errors"
+
+// [ RETURNING [ WITH ( { OLD | NEW } AS output_alias [, ...] ) ]
+//     { * | output_expression [ [ AS ] output_name ] } [, ...] ]
+
+type returningClause struct {
+	oldAlias string
+	newAlias string
+	items    returningItems
+}
+
+type returningItem struct {
+	outputExpression Exp
+	outputName       string
+}
+
+type returningItems []returningItem
+
+// appendItems returns a new returning clause with the given output expressions appended.
+func (c returningClause) appendItems(exps []Exp) returningClause {
+	newClause := c
+	newClause.items = c.items.cloneSlice(len(exps))
+	for _, exp := range exps {
+		newClause.items = append(newClause.items, returningItem{
+			outputExpression: exp,
+		})
+	}
+	return newClause
+}
+
+// setLastOutputName returns a new returning clause with the output name of the last output expression set.
+func (c returningClause) setLastOutputName(outputName string) returningClause {
+	newClause := c
+	newClause.items = c.items.cloneSlice(0)
+
+	lastIdx := len(newClause.items) - 1
+	newClause.items[lastIdx].outputName = outputName
+
+	return newClause
+}
+
+func (c returningClause) isEmpty() bool {
+	return len(c.items) == 0 && c.oldAlias == "" && c.newAlias == ""
+}
+
+var ErrReturningAliasWithoutOutputExpressions = errors.New("returning: OLD or NEW alias requires at least one output expression")
+
+func (c returningClause) WriteSQL(sb *SQLBuilder) {
+	if len(c.items) == 0 {
+		sb.AddError(ErrReturningAliasWithoutOutputExpressions)
+		return
+	}
+
+	sb.WriteString(" RETURNING ")
+	if c.oldAlias != "" || c.newAlias != "" {
+		sb.WriteString("WITH (")
+		if c.oldAlias != "" {
+			sb.WriteString("OLD AS ")
+			sb.WriteString(c.oldAlias)
+		}
+		if c.newAlias != "" {
+			if c.oldAlias != "" {
+				sb.WriteString(",")
+			}
+			sb.WriteString("NEW AS ")
+			sb.WriteString(c.newAlias)
+		}
+		sb.WriteString(") ")
+	}
+	for j, item := range c.items {
+		if j > 0 {
+			sb.WriteString(",")
+		}
+		item.outputExpression.WriteSQL(sb)
+		if item.outputName != "" {
+			sb.WriteString(" AS ")
+			sb.WriteString(item.outputName)
+		}
+	}
+}
+
+func (i returningItems) cloneSlice(additionalCapacity int) returningItems {
+	newSlice := make(returningItems, len(i), len(i)+additionalCapacity)
+	copy(newSlice, i)
+	return newSlice
+}
+
+// Old references a column of the old row (before an update or delete) in a RETURNING clause.
+// Use "*" as the column name to return all columns of the old row.
+// If an alias for the old row is set (e.g. UpdateBuilder.ReturningOldAs), reference the alias via N instead.
+//
+// Note: requires PostgreSQL 18 or later.
+func Old(columnName string) IdentExp {
+	return N("old." + columnName)
+}
+
+// New references a column of the new row (after an insert or update) in a RETURNING clause.
+// Use "*" as the column name to return all columns of the new row.
+// If an alias for the new row is set (e.g. UpdateBuilder.ReturningNewAs), reference the alias via N instead.
+//
+// Note: requires PostgreSQL 18 or later.
+func New(columnName string) IdentExp {
+	return N("new." + columnName)
+}
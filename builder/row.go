@@ -0,0 +1,54 @@
+package builder
+
+// [ROW] ( expression [, ...] )
+
+// Row builds a row constructor for row-wise comparisons.
+// A row with multiple elements is written as (a, b), a row with a single element as ROW(a).
+//
+// Rows can be compared with other rows or subqueries with the comparison operators of ExpBase:
+//
+//	Row(N("a"), N("b")).Gt(Row(Arg(1), Arg(2)))
+//	// (a,b) > ($1,$2)
+func Row(exps ...Exp) RowExp {
+	exp := RowExp{
+		exps: exps,
+	}
+	exp.Exp = exp // self-reference for base methods
+	return exp
+}
+
+// RowExp is a row constructor expression.
+type RowExp struct {
+	ExpBase
+	exps []Exp
+}
+
+func (r RowExp) IsExp() {}
+
+func (r RowExp) WriteSQL(sb *SQLBuilder) {
+	// A row with zero or one element needs the ROW keyword, otherwise it would be a parenthesized expression.
+	if len(r.exps) < 2 {
+		sb.WriteString("ROW")
+	}
+	sb.WriteRune('(')
+	for i, exp := range r.exps {
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		exp.WriteSQL(sb)
+	}
+	sb.WriteRune(')')
+}
+
+// OverlapsPeriod builds the OVERLAPS operator for two time periods given as rows of (start, end) or (start, length).
+// Do not confuse with ExpBase.Overlaps, which builds the && operator for arrays and ranges.
+//
+//	(start1, end1) OVERLAPS (start2, end2)
+//	(start1, length1) OVERLAPS (start2, length2)
+//
+// Example:
+//
+//	Row(N("starts_at"), N("ends_at")).OverlapsPeriod(Row(Arg(from), Arg(until)))
+func (r RowExp) OverlapsPeriod(rgt RowExp) Exp {
+	return r.Op("OVERLAPS", rgt)
+}
@@ -0,0 +1,56 @@
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func TestRow(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		b := qrb.Row(qrb.N("a"), qrb.N("b")).Eq(qrb.Row(qrb.Arg(1), qrb.Arg(2)))
+
+		testhelper.AssertSQLWriterEquals(t, "(a,b) = ($1,$2)", []any{1, 2}, b)
+	})
+
+	t.Run("keyset pagination", func(t *testing.T) {
+		q := qrb.Select(qrb.N("*")).
+			From(qrb.N("posts")).
+			Where(qrb.Row(qrb.N("created_at"), qrb.N("id")).Lt(qrb.Row(qrb.Arg("2023-01-01"), qrb.Arg(42)))).
+			OrderBy(qrb.N("created_at")).Desc().
+			OrderBy(qrb.N("id")).Desc().
+			Limit(qrb.Int(10))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			"SELECT * FROM posts WHERE (created_at,id) < ($1,$2) ORDER BY created_at DESC,id DESC LIMIT 10",
+			[]any{"2023-01-01", 42},
+			q,
+		)
+	})
+
+	t.Run("single element", func(t *testing.T) {
+		b := qrb.Row(qrb.N("a")).IsDistinctFrom(qrb.Row(qrb.Null()))
+
+		testhelper.AssertSQLWriterEquals(t, "ROW(a) IS DISTINCT FROM ROW(NULL)", nil, b)
+	})
+
+	t.Run("in subquery", func(t *testing.T) {
+		b := qrb.Row(qrb.N("a"), qrb.N("b")).In(qrb.Select(qrb.N("x"), qrb.N("y")).From(qrb.N("t")))
+
+		testhelper.AssertSQLWriterEquals(t, "(a,b) IN (SELECT x, y FROM t)", nil, b)
+	})
+
+	t.Run("compare with subquery", func(t *testing.T) {
+		b := qrb.Row(qrb.N("a"), qrb.N("b")).Eq(qrb.Select(qrb.N("x"), qrb.N("y")).From(qrb.N("t")).Limit(qrb.Int(1)))
+
+		testhelper.AssertSQLWriterEquals(t, "(a,b) = (SELECT x, y FROM t LIMIT 1)", nil, b)
+	})
+
+	t.Run("overlaps", func(t *testing.T) {
+		b := qrb.Row(qrb.N("starts_at"), qrb.N("ends_at")).OverlapsPeriod(qrb.Row(qrb.Arg("2023-01-01"), qrb.Interval("1 day")))
+
+		testhelper.AssertSQLWriterEquals(t, "(starts_at,ends_at) OVERLAPS ($1,INTERVAL '1 day')", []any{"2023-01-01"}, b)
+	})
+}
@@ -0,0 +1,212 @@
+package builder
+
+import "errors"
+
+// SoftDelete starts a new soft-delete policy that marks rows as deleted by setting the given column
+// instead of removing them.
+// Tables must be registered via SoftDeletePolicy.Table to be affected by the policy.
+//
+// Example:
+//
+//	policy := SoftDelete("deleted_at").Table("users").Table("posts")
+//	q := policy.Delete(DeleteFrom(N("users")).Where(N("id").Eq(Arg(id))))
+//	// UPDATE users SET deleted_at = now() WHERE id = $1 AND users.deleted_at IS NULL
+func SoftDelete(columnName string) SoftDeletePolicy {
+	return SoftDeletePolicy{
+		columnName:   columnName,
+		deletedValue: FuncExp("now", nil),
+	}
+}
+
+// SoftDeletePolicy rewrites deletes into updates and scopes selects to non-deleted rows
+// for the registered soft-deletable tables.
+type SoftDeletePolicy struct {
+	columnName   string
+	deletedValue Exp
+	tableNames   []string
+}
+
+// Table registers a table as soft-deletable.
+// The name must match the identifier used in the query (e.g. "users" or "public.users").
+func (p SoftDeletePolicy) Table(tableName string) SoftDeletePolicy {
+	newPolicy := p
+	cloneSlice(&newPolicy.tableNames, p.tableNames, 1)
+
+	newPolicy.tableNames = append(newPolicy.tableNames, tableName)
+	return newPolicy
+}
+
+// DeletedValue sets the value that is assigned to the soft-delete column when deleting (defaults to now()).
+func (p SoftDeletePolicy) DeletedValue(value Exp) SoftDeletePolicy {
+	newPolicy := p
+	newPolicy.deletedValue = value
+	return newPolicy
+}
+
+func (p SoftDeletePolicy) isSoftDeletable(from FromExp) (Identer, bool) {
+	identer, ok := from.(Identer)
+	if !ok {
+		return nil, false
+	}
+	for _, tableName := range p.tableNames {
+		if identer.Ident() == tableName {
+			return identer, true
+		}
+	}
+	return nil, false
+}
+
+// notDeleted builds the column IS NULL condition qualified by the alias or table name.
+func (p SoftDeletePolicy) notDeleted(identer Identer, alias string) Exp {
+	qualifier := alias
+	if qualifier == "" {
+		qualifier = identer.Ident()
+	}
+	return N(qualifier + "." + p.columnName).IsNull()
+}
+
+// Delete rewrites the given delete into an update that sets the soft-delete column,
+// if the target table is registered as soft-deletable.
+// Already deleted rows are excluded, so the RETURNING clause only reports newly deleted rows.
+// Soft-deletable tables in USING are scoped like the FROM items of Scope.
+// If the table is not soft-deletable, the delete is returned unchanged.
+func (p SoftDeletePolicy) Delete(b DeleteBuilder) WithQuery {
+	if _, ok := p.isSoftDeletable(b.tableName); !ok {
+		return b
+	}
+
+	whereConjunction := make([]Exp, len(b.whereConjunction), len(b.whereConjunction)+1)
+	copy(whereConjunction, b.whereConjunction)
+	whereConjunction = append(whereConjunction, p.notDeleted(b.tableName, b.alias))
+	using, whereConjunction := p.scopeFromItems(b.using, whereConjunction)
+
+	return UpdateBuilder{
+		withQueries: b.withQueries,
+		only:        b.only,
+		tableName:   b.tableName,
+		descendants: b.descendants,
+		alias:       b.alias,
+		setItems: []updateSetItem{
+			{
+				columnName: p.columnName,
+				value:      p.deletedValue,
+			},
+		},
+		from:             using,
+		whereConjunction: whereConjunction,
+		returning:        b.returning,
+	}
+}
+
+var (
+	ErrSoftDeleteOuterJoinUsing = errors.New("soft delete: cannot scope outer join with USING, use ON instead")
+	ErrSoftDeleteNullableTable  = errors.New("soft delete: cannot scope table on the nullable side of a RIGHT or FULL JOIN")
+)
+
+// Scope adds a column IS NULL condition for every soft-deletable table in the FROM clause of the select.
+// Conditions for joined tables are added to the ON condition of the join, so outer joins keep their semantics.
+// Conditions for the preserved table of a RIGHT JOIN are added to the WHERE clause.
+// A table on the nullable side of a RIGHT or FULL JOIN cannot be scoped in the WHERE clause without changing the semantics of the join,
+// so this results in ErrSoftDeleteNullableTable.
+// Nested subqueries are not scoped, apply Scope to them separately.
+func (p SoftDeletePolicy) Scope(b SelectBuilder) SelectBuilder {
+	newBuilder := b
+	newBuilder.parts = p.scopeParts(b.parts)
+	if len(b.combinations) > 0 {
+		cloneSlice(&newBuilder.combinations, b.combinations, 0)
+		for i, combination := range newBuilder.combinations {
+			newBuilder.combinations[i].parts = p.scopeParts(combination.parts)
+		}
+	}
+	return newBuilder
+}
+
+func (p SoftDeletePolicy) scopeParts(parts selectQueryParts) selectQueryParts {
+	newParts := parts
+	newParts.from, newParts.whereConjunction = p.scopeFromItems(parts.from, parts.whereConjunction)
+	return newParts
+}
+
+// scopeFromItems adds a column IS NULL condition for every soft-deletable table in the from items
+// and returns the new from items and WHERE conditions.
+func (p SoftDeletePolicy) scopeFromItems(from []fromItem, whereConjunction []Exp) ([]fromItem, []Exp) {
+	var (
+		newFrom             []fromItem
+		newWhereConjunction []Exp
+	)
+	cloneSlice(&newFrom, from, 0)
+	cloneSlice(&newWhereConjunction, whereConjunction, len(from))
+
+	nullable := nullableFromItems(from)
+	whereCond := func(i int, cond Exp) Exp {
+		if nullable[i] {
+			return errExp{err: ErrSoftDeleteNullableTable}
+		}
+		return cond
+	}
+
+	for i, item := range newFrom {
+		if j, isJoin := item.from.(join); isJoin {
+			identer, ok := p.isSoftDeletable(j.from)
+			if !ok {
+				continue
+			}
+			cond := p.notDeleted(identer, j.alias)
+			switch {
+			case j.joinType == joinTypeFull:
+				// Neither ON nor WHERE can exclude deleted rows of a table that is on both sides of the join.
+				newWhereConjunction = append(newWhereConjunction, errExp{err: ErrSoftDeleteNullableTable})
+			case j.joinType == joinTypeRight:
+				// The joined table is the preserved side, so deleted rows have to be excluded in WHERE.
+				newWhereConjunction = append(newWhereConjunction, whereCond(i, cond))
+			case j.on != nil:
+				j.on = And(j.on, cond)
+				newFrom[i].from = j
+			case j.joinType == joinTypeInner || j.joinType == joinTypeCross:
+				newWhereConjunction = append(newWhereConjunction, whereCond(i, cond))
+			default:
+				newWhereConjunction = append(newWhereConjunction, errExp{err: ErrSoftDeleteOuterJoinUsing})
+			}
+			continue
+		}
+
+		identer, ok := p.isSoftDeletable(item.from)
+		if !ok {
+			continue
+		}
+		newWhereConjunction = append(newWhereConjunction, whereCond(i, p.notDeleted(identer, item.alias)))
+	}
+
+	return newFrom, newWhereConjunction
+}
+
+// nullableFromItems marks the from items that are on the nullable side of a later RIGHT or FULL JOIN.
+// Only items of the same join chain are affected, comma separated from items start a new chain.
+func nullableFromItems(from []fromItem) []bool {
+	nullable := make([]bool, len(from))
+	chainStart := 0
+	for i, item := range from {
+		j, isJoin := item.from.(join)
+		if !isJoin {
+			chainStart = i
+			continue
+		}
+		if j.joinType == joinTypeRight || j.joinType == joinTypeFull {
+			for k := chainStart; k < i; k++ {
+				nullable[k] = true
+			}
+		}
+	}
+	return nullable
+}
+
+// errExp reports an error when it is written.
+type errExp struct {
+	err error
+}
+
+func (e errExp) IsExp() {}
+
+func (e errExp) WriteSQL(sb *SQLBuilder) {
+	sb.AddError(e.err)
+}
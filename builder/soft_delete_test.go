@@ -0,0 +1,193 @@
+package builder_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/builder"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func TestSoftDeletePolicy(t *testing.T) {
+	policy := qrb.SoftDelete("deleted_at").Table("users").Table("posts")
+
+	t.Run("delete soft-deletable table", func(t *testing.T) {
+		q := policy.Delete(
+			qrb.DeleteFrom(qrb.N("users")).
+				Where(qrb.N("id").Eq(qrb.Arg(42))).
+				Returning(qrb.N("id")).DeleteBuilder,
+		)
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`UPDATE users SET deleted_at = now() WHERE id = $1 AND users.deleted_at IS NULL RETURNING id`,
+			[]any{42},
+			q,
+		)
+	})
+
+	t.Run("delete with alias, using and custom value", func(t *testing.T) {
+		q := policy.DeletedValue(qrb.Arg("2024-01-01")).Delete(
+			qrb.DeleteFrom(qrb.N("posts")).As("p").
+				Using(qrb.N("users")).As("u").
+				Where(qrb.N("p.author_id").Eq(qrb.N("u.id"))).
+				Where(qrb.N("u.banned").Eq(qrb.Bool(true))),
+		)
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`UPDATE posts AS p SET deleted_at = $1 FROM users AS u WHERE p.author_id = u.id AND u.banned = true AND p.deleted_at IS NULL AND u.deleted_at IS NULL`,
+			[]any{"2024-01-01"},
+			q,
+		)
+	})
+
+	t.Run("delete with multiple using items", func(t *testing.T) {
+		q := policy.Delete(
+			qrb.DeleteFrom(qrb.N("posts")).
+				Using(qrb.N("accounts")).As("a").
+				Using(qrb.N("users")).As("u").
+				Where(qrb.N("posts.author_id").Eq(qrb.N("u.id"))).
+				Where(qrb.N("u.account_id").Eq(qrb.N("a.id"))),
+		)
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`UPDATE posts SET deleted_at = now() FROM accounts AS a, users AS u
+			WHERE posts.author_id = u.id AND u.account_id = a.id AND posts.deleted_at IS NULL AND u.deleted_at IS NULL`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("delete other table", func(t *testing.T) {
+		q := policy.Delete(qrb.DeleteFrom(qrb.N("sessions")).Where(qrb.N("user_id").Eq(qrb.Arg(42))))
+
+		testhelper.AssertSQLWriterEquals(t, `DELETE FROM sessions WHERE user_id = $1`, []any{42}, q)
+	})
+
+	t.Run("delete in with query", func(t *testing.T) {
+		q := qrb.With("deleted").As(
+			policy.Delete(qrb.DeleteFrom(qrb.N("users")).Where(qrb.N("id").Eq(qrb.Arg(42))).Returning(qrb.N("id")).DeleteBuilder),
+		).Select(qrb.N("id")).From(qrb.N("deleted"))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`WITH deleted AS (UPDATE users SET deleted_at = now() WHERE id = $1 AND users.deleted_at IS NULL RETURNING id) SELECT id FROM deleted`,
+			[]any{42},
+			q,
+		)
+	})
+
+	t.Run("scope select", func(t *testing.T) {
+		q := policy.Scope(
+			qrb.Select(qrb.N("u.name"), qrb.N("p.title")).
+				From(qrb.N("users")).As("u").
+				LeftJoin(qrb.N("posts")).As("p").On(qrb.N("p.author_id").Eq(qrb.N("u.id"))).
+				Join(qrb.N("comments")).As("c").On(qrb.N("c.post_id").Eq(qrb.N("p.id"))).
+				Where(qrb.N("u.active").Eq(qrb.Bool(true))),
+		)
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`SELECT u.name, p.title FROM users AS u
+			LEFT JOIN posts AS p ON p.author_id = u.id AND p.deleted_at IS NULL
+			JOIN comments AS c ON c.post_id = p.id
+			WHERE u.active = true AND u.deleted_at IS NULL`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("scope select with union", func(t *testing.T) {
+		q := policy.Scope(
+			qrb.Select(qrb.N("id")).From(qrb.N("users")).
+				Union().
+				Select(qrb.N("author_id")).From(qrb.N("posts")).SelectBuilder,
+		)
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`SELECT id FROM users WHERE users.deleted_at IS NULL UNION SELECT author_id FROM posts WHERE posts.deleted_at IS NULL`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("scope outer join with using", func(t *testing.T) {
+		q := policy.Scope(
+			qrb.Select(qrb.N("*")).
+				From(qrb.N("accounts")).
+				LeftJoin(qrb.N("users")).Using("account_id"),
+		)
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrSoftDeleteOuterJoinUsing)
+	})
+
+	t.Run("scope right join", func(t *testing.T) {
+		q := policy.Scope(
+			qrb.Select(qrb.N("*")).
+				From(qrb.N("users")).As("u").
+				RightJoin(qrb.N("accounts")).As("a").On(qrb.N("a.id").Eq(qrb.N("u.account_id"))),
+		)
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrSoftDeleteNullableTable)
+	})
+
+	t.Run("scope full join", func(t *testing.T) {
+		q := policy.Scope(
+			qrb.Select(qrb.N("*")).
+				From(qrb.N("users")).As("u").
+				FullJoin(qrb.N("posts")).As("p").On(qrb.N("p.author_id").Eq(qrb.N("u.id"))),
+		)
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrSoftDeleteNullableTable)
+	})
+
+	t.Run("scope right join in separate from item", func(t *testing.T) {
+		q := policy.Scope(
+			qrb.Select(qrb.N("*")).
+				From(qrb.N("users")).As("u").
+				From(qrb.N("accounts")).As("a").
+				RightJoin(qrb.N("plans")).As("pl").On(qrb.N("pl.id").Eq(qrb.N("a.plan_id"))),
+		)
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`SELECT * FROM users AS u, accounts AS a RIGHT JOIN plans AS pl ON pl.id = a.plan_id WHERE u.deleted_at IS NULL`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("scope full join to soft-deletable table", func(t *testing.T) {
+		q := policy.Scope(
+			qrb.Select(qrb.N("*")).
+				From(qrb.N("accounts")).As("a").
+				FullJoin(qrb.N("users")).As("u").On(qrb.N("a.id").Eq(qrb.N("u.account_id"))),
+		)
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrSoftDeleteNullableTable)
+	})
+
+	t.Run("scope right join to soft-deletable table", func(t *testing.T) {
+		q := policy.Scope(
+			qrb.Select(qrb.N("*")).
+				From(qrb.N("accounts")).As("a").
+				RightJoin(qrb.N("users")).As("u").On(qrb.N("a.id").Eq(qrb.N("u.account_id"))),
+		)
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`SELECT * FROM accounts AS a RIGHT JOIN users AS u ON a.id = u.account_id WHERE u.deleted_at IS NULL`,
+			nil,
+			q,
+		)
+	})
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+
+	"github.com/networkteam/qrb/types"
 )
 
 type expType string
@@ -39,7 +41,7 @@ var validTypeRegex = regexp.MustCompile(`(?ms)\A(` +
 	`|\\(?:\+?[0-9A-Fa-f]{4}|\+?[0-9A-Fa-f]{6})` +
 	`)+"` +
 	`)` + // End of identifier match
-	`(?:\(\d+\))?` + // Optional parentheses with numeric value
+	`(?:\(\d+(?:\s*,\s*-?\d+)?\))?` + // Optional type modifiers with precision and scale (e.g. numeric(10,2))
 	`(?:\s+UESCAPE\s+'[^0-9A-Fa-f"+''"[:space:]]')?` + // Optional UESCAPE clause with single character not in the excluded set
 	`(\s*\[\s*\d*\s*\])*` + // Match array notation: zero or more `[]`, optionally with spaces and a number
 	`\z` + // End of string
@@ -49,3 +51,67 @@ var validTypeRegex = regexp.MustCompile(`(?ms)\A(` +
 func isValidType(s string) bool {
 	return validTypeRegex.MatchString(s)
 }
+
+// Cast builds a type cast in the SQL-standard function syntax.
+// It is equivalent to ExpBase.Cast, which uses the PostgreSQL-specific :: syntax.
+//
+// Example:
+//
+//	Cast(Arg("42"), "integer")
+//	// CAST($1 AS integer)
+func Cast(exp Exp, typ types.Type) ExpBase {
+	e := castExp{
+		exp: exp,
+		typ: expType(typ),
+	}
+	return ExpBase{Exp: e}
+}
+
+type castExp struct {
+	exp Exp
+	typ expType
+}
+
+func (c castExp) IsExp() {}
+
+func (c castExp) WriteSQL(sb *SQLBuilder) {
+	sb.WriteString("CAST(")
+	c.exp.WriteSQL(sb)
+	sb.WriteString(" AS ")
+	c.typ.WriteSQL(sb)
+	sb.WriteRune(')')
+}
+
+// Regclass builds a constant of the regclass type for the given relation (e.g. a table or sequence).
+// The identifier is validated like N and written as a string literal that is cast to regclass.
+//
+// Example:
+//
+//	Regclass(N("public.order_number_seq"))
+//	// 'public.order_number_seq'::regclass
+func Regclass(relation Identer) ExpBase {
+	return ExpBase{
+		Exp: regclassExp{
+			relation: relation,
+		},
+	}
+}
+
+type regclassExp struct {
+	relation Identer
+}
+
+func (r regclassExp) IsExp() {}
+
+func (r regclassExp) WriteSQL(sb *SQLBuilder) {
+	ident := r.relation.Ident()
+	if sb.Validating() {
+		if !isValidIdentifier(ident) {
+			sb.AddError(fmt.Errorf("%w: %s", ErrInvalidIdentifier, ident))
+			return
+		}
+	}
+
+	sb.WriteString(pqQuoteLiteral(ident))
+	sb.WriteString("::regclass")
+}
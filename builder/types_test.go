@@ -21,12 +21,16 @@ func TestExpType(t *testing.T) {
 		`"QuotedArrayType"[]`,
 		`integer[][]`,
 		`text[16]`,
+		`numeric(10,2)`,
+		`numeric(10, -2)`,
+		`numeric(10,2)[]`,
 	}
 
 	invalidIdentifiers := []string{
 		"1int",
 		`"MyTable.name`,
 		`My"Table.name`,
+		`numeric(10,)`,
 	}
 
 	for _, id := range validTypes {
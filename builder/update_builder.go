@@ -1,6 +1,12 @@
 package builder
 
-import "sort"
+import (
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/networkteam/qrb/types"
+)
 
 // [ WITH [ RECURSIVE ] with_query [, ...] ]
 // UPDATE [ ONLY ] table_name [ * ] [ [ AS ] alias ]
@@ -20,12 +26,14 @@ func Update(tableName Identer) UpdateBuilder {
 
 type UpdateBuilder struct {
 	withQueries      withQueries
+	only             bool
 	tableName        Identer
+	descendants      bool
 	alias            string
 	setItems         []updateSetItem
 	from             []fromItem
 	whereConjunction []Exp
-	returningItems   returningItems
+	returning        returningClause
 }
 
 func (b UpdateBuilder) isWithQuery() {}
@@ -35,6 +43,22 @@ type updateSetItem struct {
 	value      Exp
 }
 
+// Only adds ONLY before the table name, so only the named table is affected and not any tables inheriting from it
+// (or partitions of a partitioned table).
+func (b UpdateBuilder) Only() UpdateBuilder {
+	newBuilder := b
+	newBuilder.only = true
+	return newBuilder
+}
+
+// WithDescendants adds * after the table name to explicitly include descendant tables.
+// This is the default behavior of PostgreSQL, so it is only needed to make the intention explicit.
+func (b UpdateBuilder) WithDescendants() UpdateBuilder {
+	newBuilder := b
+	newBuilder.descendants = true
+	return newBuilder
+}
+
 func (b UpdateBuilder) As(alias string) UpdateBuilder {
 	newBuilder := b
 	newBuilder.alias = alias
@@ -116,6 +140,151 @@ func (b FromUpdateBuilder) ColumnAliases(aliases ...string) FromUpdateBuilder {
 	return newBuilder
 }
 
+// ValuesColumn describes a column of rows given to UpdateBuilder.FromRows.
+// If Type is set, the value of the first row is cast to the type, so PostgreSQL infers the column type correctly.
+type ValuesColumn struct {
+	Name string
+	Type types.Type
+}
+
+const defaultFromRowsAlias = "v"
+
+// FromRows adds a VALUES list with the given rows as a FROM item to update multiple rows with different values.
+// The VALUES list gets the alias "v" (change with FromRowsUpdateBuilder.ValuesAs) and the column names as column aliases.
+// Call FromRowsUpdateBuilder.Key to set the non-key columns from the rows and join on the key columns.
+// At least one column must not be a key column, otherwise there is nothing to set.
+//
+// Example:
+//
+//	Update(N("products")).
+//		FromRows([]ValuesColumn{{Name: "id", Type: "int"}, {Name: "price", Type: "numeric"}}, [][]Exp{
+//			{Arg(1), Arg(9.99)},
+//			{Arg(2), Arg(19.99)},
+//		}).
+//		Key("id")
+//
+//	UPDATE products SET price = v.price FROM (VALUES ($1::int,$2::numeric),($3,$4)) AS v (id,price) WHERE products.id = v.id
+func (b UpdateBuilder) FromRows(columns []ValuesColumn, rows [][]Exp) FromRowsUpdateBuilder {
+	columnNames := make([]string, len(columns))
+	for i, column := range columns {
+		columnNames[i] = column.Name
+	}
+
+	newBuilder := b.
+		From(valuesExp{columns: columns, rows: rows}).
+		As(defaultFromRowsAlias).
+		ColumnAliases(columnNames...)
+
+	return FromRowsUpdateBuilder{
+		UpdateBuilder: newBuilder.UpdateBuilder,
+		columns:       columns,
+	}
+}
+
+type FromRowsUpdateBuilder struct {
+	UpdateBuilder
+	columns []ValuesColumn
+}
+
+// ValuesAs sets the alias for the VALUES list (defaults to "v").
+func (b FromRowsUpdateBuilder) ValuesAs(alias string) FromRowsUpdateBuilder {
+	newBuilder := b
+	newBuilder.UpdateBuilder = FromUpdateBuilder{b.UpdateBuilder}.As(alias).UpdateBuilder
+	return newBuilder
+}
+
+var ErrUpdateFromRowsUnknownKey = errors.New("update: key column not in rows columns")
+
+// Key sets all non-key columns of the rows and adds a join condition for each key column between the updated table and the rows.
+func (b FromRowsUpdateBuilder) Key(columnName string, columnNames ...string) UpdateBuilder {
+	keyColumnNames := append([]string{columnName}, columnNames...)
+
+	valuesAlias := b.from[len(b.from)-1].alias
+	tableQualifier := b.alias
+	if tableQualifier == "" {
+		tableQualifier = b.tableName.Ident()
+	}
+
+	newBuilder := b.UpdateBuilder
+	for _, keyColumnName := range keyColumnNames {
+		if !b.hasColumn(keyColumnName) {
+			newBuilder = newBuilder.Where(errExp{err: fmt.Errorf("%w: %s", ErrUpdateFromRowsUnknownKey, keyColumnName)})
+			continue
+		}
+		newBuilder = newBuilder.Where(N(tableQualifier + "." + keyColumnName).Eq(N(valuesAlias + "." + keyColumnName)))
+	}
+
+columns:
+	for _, column := range b.columns {
+		for _, keyColumnName := range keyColumnNames {
+			if column.Name == keyColumnName {
+				continue columns
+			}
+		}
+		newBuilder = newBuilder.Set(column.Name, N(valuesAlias+"."+column.Name))
+	}
+
+	return newBuilder
+}
+
+func (b FromRowsUpdateBuilder) hasColumn(columnName string) bool {
+	for _, column := range b.columns {
+		if column.Name == columnName {
+			return true
+		}
+	}
+	return false
+}
+
+// VALUES ( expression [, ...] ) [, ...]
+
+type valuesExp struct {
+	columns []ValuesColumn
+	rows    [][]Exp
+}
+
+func (v valuesExp) isFromExp() {}
+
+var (
+	ErrValuesNoRows         = errors.New("values: no rows given")
+	ErrValuesRowColumnCount = errors.New("values: row does not match column count")
+)
+
+func (v valuesExp) WriteSQL(sb *SQLBuilder) {
+	if len(v.rows) == 0 {
+		sb.AddError(ErrValuesNoRows)
+		return
+	}
+
+	sb.WriteString("(VALUES ")
+	for i, row := range v.rows {
+		if len(row) != len(v.columns) {
+			sb.AddError(fmt.Errorf("%w: row %d has %d values, expected %d", ErrValuesRowColumnCount, i+1, len(row), len(v.columns)))
+			return
+		}
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		sb.WriteRune('(')
+		for j, value := range row {
+			if j > 0 {
+				sb.WriteRune(',')
+			}
+			// Cast the values of the first row, the following rows get the same types.
+			if i == 0 && v.columns[j].Type != "" {
+				base, isExpBase := value.(ExpBase)
+				if !isExpBase {
+					base = ExpBase{Exp: value}
+				}
+				value = base.Cast(v.columns[j].Type)
+			}
+			value.WriteSQL(sb)
+		}
+		sb.WriteRune(')')
+	}
+	sb.WriteRune(')')
+}
+
 // Where adds a WHERE condition to the update.
 // Multiple calls to Where are joined with AND.
 func (b UpdateBuilder) Where(cond Exp) UpdateBuilder {
@@ -126,17 +295,33 @@ func (b UpdateBuilder) Where(cond Exp) UpdateBuilder {
 	return newBuilder
 }
 
-func (b UpdateBuilder) Returning(outputExpression Exp) ReturningUpdateBuilder {
+// Returning adds the given output expressions to the RETURNING clause.
+// It can be called multiple times to add more output expressions.
+func (b UpdateBuilder) Returning(outputExpression Exp, exps ...Exp) ReturningUpdateBuilder {
 	newBuilder := b
-	newBuilder.returningItems = b.returningItems.cloneSlice(1)
-
-	newBuilder.returningItems = append(newBuilder.returningItems, returningItem{
-		outputExpression: outputExpression,
-	})
+	newBuilder.returning = b.returning.appendItems(append([]Exp{outputExpression}, exps...))
 
 	return ReturningUpdateBuilder{newBuilder}
 }
 
+// ReturningOldAs sets an alias for the OLD row in the RETURNING clause (RETURNING WITH (OLD AS alias)).
+//
+// Note: requires PostgreSQL 18 or later.
+func (b UpdateBuilder) ReturningOldAs(alias string) UpdateBuilder {
+	newBuilder := b
+	newBuilder.returning.oldAlias = alias
+	return newBuilder
+}
+
+// ReturningNewAs sets an alias for the NEW row in the RETURNING clause (RETURNING WITH (NEW AS alias)).
+//
+// Note: requires PostgreSQL 18 or later.
+func (b UpdateBuilder) ReturningNewAs(alias string) UpdateBuilder {
+	newBuilder := b
+	newBuilder.returning.newAlias = alias
+	return newBuilder
+}
+
 type ReturningUpdateBuilder struct {
 	UpdateBuilder
 }
@@ -144,10 +329,7 @@ type ReturningUpdateBuilder struct {
 // As sets the output name for the last output expression.
 func (b ReturningUpdateBuilder) As(outputName string) UpdateBuilder {
 	newBuilder := b.UpdateBuilder
-	newBuilder.returningItems = b.returningItems.cloneSlice(0)
-
-	lastIdx := len(newBuilder.returningItems) - 1
-	newBuilder.returningItems[lastIdx].outputName = outputName
+	newBuilder.returning = b.returning.setLastOutputName(outputName)
 
 	return newBuilder
 }
@@ -159,17 +341,36 @@ func (b UpdateBuilder) WriteSQL(sb *SQLBuilder) {
 	sb.WriteRune(')')
 }
 
+var (
+	ErrUpdateOnlyAndDescendants = errors.New("update: cannot specify both ONLY and *")
+	ErrUpdateNoSetItems         = errors.New("update: no columns to set")
+)
+
 func (b UpdateBuilder) innerWriteSQL(sb *SQLBuilder) {
 	if len(b.withQueries) > 0 {
 		b.withQueries.WriteSQL(sb)
 	}
 
 	sb.WriteString("UPDATE ")
+	if b.only && b.descendants {
+		sb.AddError(ErrUpdateOnlyAndDescendants)
+		return
+	}
+	if b.only {
+		sb.WriteString("ONLY ")
+	}
 	b.tableName.WriteSQL(sb)
+	if b.descendants {
+		sb.WriteString(" *")
+	}
 	if b.alias != "" {
 		sb.WriteString(" AS ")
 		sb.WriteString(b.alias)
 	}
+	if len(b.setItems) == 0 {
+		sb.AddError(ErrUpdateNoSetItems)
+		return
+	}
 	sb.WriteString(" SET ")
 	for i, setItem := range b.setItems {
 		if i > 0 {
@@ -192,8 +393,8 @@ func (b UpdateBuilder) innerWriteSQL(sb *SQLBuilder) {
 		sb.WriteString(" WHERE ")
 		And(b.whereConjunction...).WriteSQL(sb)
 	}
-	if len(b.returningItems) > 0 {
-		b.returningItems.WriteSQL(sb)
+	if !b.returning.isEmpty() {
+		b.returning.WriteSQL(sb)
 	}
 }
 
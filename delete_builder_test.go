@@ -3,6 +3,8 @@ package qrb_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/networkteam/qrb"
 	"github.com/networkteam/qrb/builder"
 	"github.com/networkteam/qrb/fn"
@@ -99,6 +101,107 @@ func TestDeleteBuilder(t *testing.T) {
 		})
 	})
 
+	t.Run("returning multiple outputs", func(t *testing.T) {
+		q := qrb.
+			DeleteFrom(qrb.N("tasks")).
+			Where(qrb.N("status").Eq(qrb.String("DONE"))).
+			Returning(qrb.N("id"), qrb.N("title"))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			DELETE FROM tasks WHERE status = 'DONE' RETURNING id, title
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("returning old with alias", func(t *testing.T) {
+		q := qrb.
+			DeleteFrom(qrb.N("tasks")).
+			Where(qrb.N("status").Eq(qrb.String("DONE"))).
+			ReturningOldAs("deleted").
+			Returning(qrb.N("deleted.*"))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			DELETE FROM tasks WHERE status = 'DONE' RETURNING WITH (OLD AS deleted) deleted.*
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("only", func(t *testing.T) {
+		q := qrb.
+			DeleteFrom(qrb.N("measurements")).
+			Only().
+			Where(qrb.N("logdate").Lt(qrb.String("2006-01-01")))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			DELETE FROM ONLY measurements WHERE logdate < '2006-01-01'
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("with descendants", func(t *testing.T) {
+		q := qrb.
+			DeleteFrom(qrb.N("measurements")).
+			WithDescendants().
+			As("m").
+			Where(qrb.N("m.logdate").Lt(qrb.String("2006-01-01")))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			DELETE FROM measurements * AS m WHERE m.logdate < '2006-01-01'
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("only and with descendants", func(t *testing.T) {
+		q := qrb.
+			DeleteFrom(qrb.N("measurements")).
+			Only().
+			WithDescendants()
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrDeleteOnlyAndDescendants)
+	})
+
+	t.Run("only in with query", func(t *testing.T) {
+		q := qrb.
+			With("deleted").As(
+			qrb.DeleteFrom(qrb.N("measurements")).
+				Only().
+				Where(qrb.N("logdate").Lt(qrb.String("2006-01-01"))).
+				Returning(qrb.N("id")),
+		).
+			DeleteFrom(qrb.N("measurement_details")).
+			Only().
+			Where(qrb.N("measurement_id").In(qrb.Select(qrb.N("id")).From(qrb.N("deleted"))))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			WITH deleted AS (
+				DELETE FROM ONLY measurements WHERE logdate < '2006-01-01' RETURNING id
+			)
+			DELETE FROM ONLY measurement_details WHERE measurement_id IN (SELECT id FROM deleted)
+			`,
+			nil,
+			q,
+		)
+	})
+
 	t.Run("with", func(t *testing.T) {
 		// Example borrowed from https://stackoverflow.com/a/37225172/749191
 
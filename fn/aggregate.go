@@ -19,6 +19,15 @@ func ArrayAgg(exp builder.Exp) builder.AggBuilder {
 	return builder.Agg("array_agg", []builder.Exp{exp})
 }
 
+// AnyValue builds the any_value aggregate function.
+//
+//	any_value ( anyelement ) → same as input type
+//
+// Returns an arbitrary value from the non-null input values.
+func AnyValue(exp builder.Exp) builder.AggBuilder {
+	return builder.Agg("any_value", []builder.Exp{exp})
+}
+
 // Avg builds the avg aggregate function.
 //
 //	avg ( T ) → T
@@ -90,6 +99,15 @@ func Count(exp builder.Exp) builder.AggBuilder {
 	return builder.Agg("count", []builder.Exp{exp})
 }
 
+// Every builds the every aggregate function.
+//
+//	every ( boolean ) → boolean
+//
+// This is the SQL standard's equivalent to bool_and.
+func Every(exp builder.Exp) builder.AggBuilder {
+	return builder.Agg("every", []builder.Exp{exp})
+}
+
 // JsonAgg builds the json_agg aggregate function.
 //
 //	json_agg ( anyelement ) → json
@@ -192,7 +210,169 @@ func Xmlagg(exp builder.Exp) builder.AggBuilder {
 	return builder.Agg("xmlagg", []builder.Exp{exp})
 }
 
-// --- TODO Aggregate Functions for Statistics
+// --- Aggregate Functions for Statistics
+
+// Corr builds the corr aggregate function.
+//
+//	corr ( Y double precision, X double precision ) → double precision
+//
+// Computes the correlation coefficient.
+func Corr(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("corr", []builder.Exp{y, x})
+}
+
+// CovarPop builds the covar_pop aggregate function.
+//
+//	covar_pop ( Y double precision, X double precision ) → double precision
+//
+// Computes the population covariance.
+func CovarPop(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("covar_pop", []builder.Exp{y, x})
+}
+
+// CovarSamp builds the covar_samp aggregate function.
+//
+//	covar_samp ( Y double precision, X double precision ) → double precision
+//
+// Computes the sample covariance.
+func CovarSamp(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("covar_samp", []builder.Exp{y, x})
+}
+
+// RegrAvgx builds the regr_avgx aggregate function.
+//
+//	regr_avgx ( Y double precision, X double precision ) → double precision
+//
+// Computes the average of the independent variable, sum(X)/N.
+func RegrAvgx(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_avgx", []builder.Exp{y, x})
+}
+
+// RegrAvgy builds the regr_avgy aggregate function.
+//
+//	regr_avgy ( Y double precision, X double precision ) → double precision
+//
+// Computes the average of the dependent variable, sum(Y)/N.
+func RegrAvgy(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_avgy", []builder.Exp{y, x})
+}
+
+// RegrCount builds the regr_count aggregate function.
+//
+//	regr_count ( Y double precision, X double precision ) → bigint
+//
+// Computes the number of rows in which both inputs are non-null.
+func RegrCount(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_count", []builder.Exp{y, x})
+}
+
+// RegrIntercept builds the regr_intercept aggregate function.
+//
+//	regr_intercept ( Y double precision, X double precision ) → double precision
+//
+// Computes the y-intercept of the least-squares-fit linear equation determined by the (X, Y) pairs.
+func RegrIntercept(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_intercept", []builder.Exp{y, x})
+}
+
+// RegrR2 builds the regr_r2 aggregate function.
+//
+//	regr_r2 ( Y double precision, X double precision ) → double precision
+//
+// Computes the square of the correlation coefficient.
+func RegrR2(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_r2", []builder.Exp{y, x})
+}
+
+// RegrSlope builds the regr_slope aggregate function.
+//
+//	regr_slope ( Y double precision, X double precision ) → double precision
+//
+// Computes the slope of the least-squares-fit linear equation determined by the (X, Y) pairs.
+func RegrSlope(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_slope", []builder.Exp{y, x})
+}
+
+// RegrSxx builds the regr_sxx aggregate function.
+//
+//	regr_sxx ( Y double precision, X double precision ) → double precision
+//
+// Computes the “sum of squares” of the independent variable, sum(X^2) - sum(X)^2/N.
+func RegrSxx(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_sxx", []builder.Exp{y, x})
+}
+
+// RegrSxy builds the regr_sxy aggregate function.
+//
+//	regr_sxy ( Y double precision, X double precision ) → double precision
+//
+// Computes the “sum of products” of independent times dependent variables, sum(X*Y) - sum(X) * sum(Y)/N.
+func RegrSxy(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_sxy", []builder.Exp{y, x})
+}
+
+// RegrSyy builds the regr_syy aggregate function.
+//
+//	regr_syy ( Y double precision, X double precision ) → double precision
+//
+// Computes the “sum of squares” of the dependent variable, sum(Y^2) - sum(Y)^2/N.
+func RegrSyy(y, x builder.Exp) builder.AggBuilder {
+	return builder.Agg("regr_syy", []builder.Exp{y, x})
+}
+
+// Stddev builds the stddev aggregate function.
+//
+//	stddev ( numeric_type ) → double precision for real or double precision, otherwise numeric
+//
+// This is a historical alias for stddev_samp.
+func Stddev(exp builder.Exp) builder.AggBuilder {
+	return builder.Agg("stddev", []builder.Exp{exp})
+}
+
+// StddevPop builds the stddev_pop aggregate function.
+//
+//	stddev_pop ( numeric_type ) → double precision for real or double precision, otherwise numeric
+//
+// Computes the population standard deviation of the input values.
+func StddevPop(exp builder.Exp) builder.AggBuilder {
+	return builder.Agg("stddev_pop", []builder.Exp{exp})
+}
+
+// StddevSamp builds the stddev_samp aggregate function.
+//
+//	stddev_samp ( numeric_type ) → double precision for real or double precision, otherwise numeric
+//
+// Computes the sample standard deviation of the input values.
+func StddevSamp(exp builder.Exp) builder.AggBuilder {
+	return builder.Agg("stddev_samp", []builder.Exp{exp})
+}
+
+// Variance builds the variance aggregate function.
+//
+//	variance ( numeric_type ) → double precision for real or double precision, otherwise numeric
+//
+// This is a historical alias for var_samp.
+func Variance(exp builder.Exp) builder.AggBuilder {
+	return builder.Agg("variance", []builder.Exp{exp})
+}
+
+// VarPop builds the var_pop aggregate function.
+//
+//	var_pop ( numeric_type ) → double precision for real or double precision, otherwise numeric
+//
+// Computes the population variance of the input values (square of the population standard deviation).
+func VarPop(exp builder.Exp) builder.AggBuilder {
+	return builder.Agg("var_pop", []builder.Exp{exp})
+}
+
+// VarSamp builds the var_samp aggregate function.
+//
+//	var_samp ( numeric_type ) → double precision for real or double precision, otherwise numeric
+//
+// Computes the sample variance of the input values (square of the sample standard deviation).
+func VarSamp(exp builder.Exp) builder.AggBuilder {
+	return builder.Agg("var_samp", []builder.Exp{exp})
+}
 
 // --- Ordered-Set Aggregate Functions
 
@@ -217,6 +397,18 @@ func PercentileCont(fraction builder.Exp) builder.AggBuilder {
 	return builder.Agg("percentile_cont", []builder.Exp{fraction})
 }
 
+// PercentileContFractions builds the percentile_cont aggregate function for multiple fractions.
+// The fractions are passed as a double precision array, the result is an array with the percentile of each fraction.
+// To pass the whole array as a single argument, use PercentileCont(builder.Arg(fractions).Cast("float8[]")) instead.
+//
+// Example:
+//
+//	fn.PercentileContFractions(builder.Float(0.5), builder.Float(0.9), builder.Arg(p)).WithinGroup().OrderBy(builder.N("duration"))
+//	// percentile_cont(ARRAY[0.5,0.9,$1]::float8[]) WITHIN GROUP (ORDER BY duration)
+func PercentileContFractions(fractions ...builder.Exp) builder.AggBuilder {
+	return builder.Agg("percentile_cont", []builder.Exp{fractionsArray(fractions)})
+}
+
 // PercentileDisc builds the percentile_disc aggregate function.
 //
 //	percentile_disc ( fraction double precision ) WITHIN GROUP ( ORDER BY anyelement ) → anyelement
@@ -230,6 +422,17 @@ func PercentileDisc(fraction builder.Exp) builder.AggBuilder {
 	return builder.Agg("percentile_disc", []builder.Exp{fraction})
 }
 
+// PercentileDiscFractions builds the percentile_disc aggregate function for multiple fractions.
+// The fractions are passed as a double precision array, the result is an array with the percentile of each fraction.
+// To pass the whole array as a single argument, use PercentileDisc(builder.Arg(fractions).Cast("float8[]")) instead.
+func PercentileDiscFractions(fractions ...builder.Exp) builder.AggBuilder {
+	return builder.Agg("percentile_disc", []builder.Exp{fractionsArray(fractions)})
+}
+
+func fractionsArray(fractions []builder.Exp) builder.Exp {
+	return builder.Array(fractions...).Cast("float8[]")
+}
+
 // --- Hypothetical-Set Aggregate Functions
 
 // Rank builds the rank aggregate function.
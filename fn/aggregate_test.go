@@ -167,6 +167,76 @@ func TestAggregateExpressions(t *testing.T) {
 				fn:          fn.Xmlagg(qrb.N("title")),
 				expectedSQL: "xmlagg(title)",
 			},
+			{
+				name:        "any_value",
+				fn:          fn.AnyValue(qrb.N("title")),
+				expectedSQL: "any_value(title)",
+			},
+			{
+				name:        "every",
+				fn:          fn.Every(qrb.N("active")),
+				expectedSQL: "every(active)",
+			},
+			{
+				name:        "array_agg of arrays",
+				fn:          fn.ArrayAgg(qrb.N("tags")).OrderBy(qrb.N("id")),
+				expectedSQL: "array_agg(tags ORDER BY id)",
+			},
+			{
+				name:        "corr",
+				fn:          fn.Corr(qrb.N("price"), qrb.N("rating")),
+				expectedSQL: "corr(price,rating)",
+			},
+			{
+				name:        "covar_pop",
+				fn:          fn.CovarPop(qrb.N("price"), qrb.N("rating")),
+				expectedSQL: "covar_pop(price,rating)",
+			},
+			{
+				name:        "covar_samp",
+				fn:          fn.CovarSamp(qrb.N("price"), qrb.N("rating")),
+				expectedSQL: "covar_samp(price,rating)",
+			},
+			{
+				name:        "regr_slope",
+				fn:          fn.RegrSlope(qrb.N("sales"), qrb.N("spend")),
+				expectedSQL: "regr_slope(sales,spend)",
+			},
+			{
+				name:        "regr_intercept",
+				fn:          fn.RegrIntercept(qrb.N("sales"), qrb.N("spend")),
+				expectedSQL: "regr_intercept(sales,spend)",
+			},
+			{
+				name:        "regr_r2",
+				fn:          fn.RegrR2(qrb.N("sales"), qrb.N("spend")),
+				expectedSQL: "regr_r2(sales,spend)",
+			},
+			{
+				name:        "regr_count",
+				fn:          fn.RegrCount(qrb.N("sales"), qrb.N("spend")),
+				expectedSQL: "regr_count(sales,spend)",
+			},
+			{
+				name:        "stddev_samp",
+				fn:          fn.StddevSamp(qrb.N("price")),
+				expectedSQL: "stddev_samp(price)",
+			},
+			{
+				name:        "stddev_pop with filter",
+				fn:          fn.StddevPop(qrb.N("price")).Filter(qrb.N("active")),
+				expectedSQL: "stddev_pop(price) FILTER (WHERE active)",
+			},
+			{
+				name:        "var_samp",
+				fn:          fn.VarSamp(qrb.N("price")),
+				expectedSQL: "var_samp(price)",
+			},
+			{
+				name:        "var_pop",
+				fn:          fn.VarPop(qrb.N("price")),
+				expectedSQL: "var_pop(price)",
+			},
 			{
 				name:        "mode",
 				fn:          fn.Mode().WithinGroup().OrderBy(qrb.N("price")).Asc(),
@@ -182,6 +252,31 @@ func TestAggregateExpressions(t *testing.T) {
 				fn:          fn.PercentileDisc(qrb.Float(0.5)).WithinGroup().OrderBy(qrb.N("price")).Asc(),
 				expectedSQL: "percentile_disc(0.5) WITHIN GROUP (ORDER BY price ASC)",
 			},
+			{
+				name:        "percentile_cont with fractions",
+				fn:          fn.PercentileContFractions(qrb.Float(0.5), qrb.Float(0.9), qrb.Float(0.99)).WithinGroup().OrderBy(qrb.N("duration")),
+				expectedSQL: "percentile_cont(ARRAY[0.5,0.9,0.99]::float8[]) WITHIN GROUP (ORDER BY duration)",
+			},
+			{
+				name:        "percentile_disc with fractions",
+				fn:          fn.PercentileDiscFractions(qrb.Float(0.25), qrb.Float(0.75)).WithinGroup().OrderBy(qrb.N("price")),
+				expectedSQL: "percentile_disc(ARRAY[0.25,0.75]::float8[]) WITHIN GROUP (ORDER BY price)",
+			},
+			{
+				name:        "percentile_cont with fraction arguments",
+				fn:          fn.PercentileContFractions(qrb.Arg(0.5), qrb.Arg(0.9)).WithinGroup().OrderBy(qrb.N("duration")),
+				expectedSQL: "percentile_cont(ARRAY[$1,$2]::float8[]) WITHIN GROUP (ORDER BY duration)",
+			},
+			{
+				name:        "percentile_disc with array argument",
+				fn:          fn.PercentileDisc(qrb.Arg([]float64{0.25, 0.75}).Cast("float8[]")).WithinGroup().OrderBy(qrb.N("price")),
+				expectedSQL: "percentile_disc($1::float8[]) WITHIN GROUP (ORDER BY price)",
+			},
+			{
+				name:        "percentile_cont with array argument",
+				fn:          fn.PercentileCont(qrb.Arg([]float64{0.5, 0.9}).Cast("float8[]")).WithinGroup().OrderBy(qrb.N("duration")),
+				expectedSQL: "percentile_cont($1::float8[]) WITHIN GROUP (ORDER BY duration)",
+			},
 			{
 				name:        "rank",
 				fn:          fn.Rank().WithinGroup().OrderBy(qrb.N("price")).Asc(),
@@ -1,6 +1,13 @@
 package fn
 
-import "github.com/networkteam/qrb/builder"
+import (
+	"errors"
+	"strconv"
+
+	"github.com/networkteam/qrb/builder"
+)
+
+// See https://www.postgresql.org/docs/current/functions-datetime.html
 
 // EXTRACT(field FROM source)
 
@@ -28,3 +35,313 @@ func (c extractExp) WriteSQL(sb *builder.SQLBuilder) {
 	c.from.WriteSQL(sb)
 	sb.WriteRune(')')
 }
+
+// --- Current date/time
+
+// Now builds the now function.
+//
+//	now ( ) → timestamp with time zone
+//
+// Current date and time (start of current transaction).
+func Now() builder.ExpBase {
+	return builder.FuncExp("now", nil)
+}
+
+// ClockTimestamp builds the clock_timestamp function.
+//
+//	clock_timestamp ( ) → timestamp with time zone
+//
+// Current date and time (changes during statement execution).
+func ClockTimestamp() builder.ExpBase {
+	return builder.FuncExp("clock_timestamp", nil)
+}
+
+// StatementTimestamp builds the statement_timestamp function.
+//
+//	statement_timestamp ( ) → timestamp with time zone
+//
+// Current date and time (start of current statement).
+func StatementTimestamp() builder.ExpBase {
+	return builder.FuncExp("statement_timestamp", nil)
+}
+
+// TransactionTimestamp builds the transaction_timestamp function.
+//
+//	transaction_timestamp ( ) → timestamp with time zone
+//
+// Current date and time (start of current transaction).
+func TransactionTimestamp() builder.ExpBase {
+	return builder.FuncExp("transaction_timestamp", nil)
+}
+
+// CurrentDate builds the CURRENT_DATE value.
+func CurrentDate() builder.ExpBase {
+	return builder.ExpBase{Exp: sqlValueFuncExp{name: "CURRENT_DATE"}}
+}
+
+// CurrentTime builds the CURRENT_TIME value with an optional precision.
+func CurrentTime(precision ...int) builder.ExpBase {
+	return builder.ExpBase{Exp: newSQLValueFuncExp("CURRENT_TIME", precision)}
+}
+
+// CurrentTimestamp builds the CURRENT_TIMESTAMP value with an optional precision.
+func CurrentTimestamp(precision ...int) builder.ExpBase {
+	return builder.ExpBase{Exp: newSQLValueFuncExp("CURRENT_TIMESTAMP", precision)}
+}
+
+// LocalTime builds the LOCALTIME value with an optional precision.
+func LocalTime(precision ...int) builder.ExpBase {
+	return builder.ExpBase{Exp: newSQLValueFuncExp("LOCALTIME", precision)}
+}
+
+// LocalTimestamp builds the LOCALTIMESTAMP value with an optional precision.
+func LocalTimestamp(precision ...int) builder.ExpBase {
+	return builder.ExpBase{Exp: newSQLValueFuncExp("LOCALTIMESTAMP", precision)}
+}
+
+func newSQLValueFuncExp(name string, precision []int) sqlValueFuncExp {
+	if len(precision) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	e := sqlValueFuncExp{name: name}
+	if len(precision) > 0 {
+		e.precision = &precision[0]
+	}
+	return e
+}
+
+// sqlValueFuncExp writes SQL-standard functions that are called without parentheses (e.g. CURRENT_TIMESTAMP).
+type sqlValueFuncExp struct {
+	name      string
+	precision *int
+}
+
+func (e sqlValueFuncExp) IsExp() {}
+
+func (e sqlValueFuncExp) WriteSQL(sb *builder.SQLBuilder) {
+	sb.WriteString(e.name)
+	if e.precision != nil {
+		sb.WriteRune('(')
+		sb.WriteString(strconv.Itoa(*e.precision))
+		sb.WriteRune(')')
+	}
+}
+
+// --- Truncation and binning
+
+// DateTrunc builds the date_trunc function.
+//
+//	date_trunc ( text, timestamp ) → timestamp
+//	date_trunc ( text, timestamp with time zone [, text ] ) → timestamp with time zone
+//	date_trunc ( text, interval ) → interval
+//
+// Truncates the source to the specified precision (e.g. "day", "week" or "month").
+// The optional time zone is used for truncating a timestamp with time zone.
+//
+// Example:
+//
+//	fn.DateTrunc("day", qrb.N("created_at"), qrb.String("Europe/Berlin"))
+//	// date_trunc('day', created_at, 'Europe/Berlin')
+func DateTrunc(field string, source builder.Exp, timeZone ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("date_trunc", withOptional([]builder.Exp{builder.String(field), source}, timeZone))
+}
+
+// DateBin builds the date_bin function.
+//
+//	date_bin ( interval, timestamp, timestamp ) → timestamp
+//
+// Bins the input into the specified interval (the stride) aligned with the specified origin.
+//
+// Example:
+//
+//	fn.DateBin(qrb.IntervalFromDuration(15*time.Minute), qrb.N("created_at"), qrb.String("2000-01-01"))
+//	// date_bin(INTERVAL '15 minutes', created_at, '2000-01-01')
+func DateBin(stride builder.Exp, source builder.Exp, origin builder.Exp) builder.ExpBase {
+	return builder.FuncExp("date_bin", []builder.Exp{stride, source, origin})
+}
+
+// DatePart builds the date_part function.
+//
+//	date_part ( text, timestamp ) → double precision
+//
+// Get timestamp subfield (equivalent to Extract, but returns double precision).
+func DatePart(field string, source builder.Exp) builder.ExpBase {
+	return builder.FuncExp("date_part", []builder.Exp{builder.String(field), source})
+}
+
+// Age builds the age function.
+//
+//	age ( timestamp, timestamp ) → interval
+//	age ( timestamp ) → interval
+//
+// Subtract arguments, producing a “symbolic” result that uses years and months, rather than just days.
+// With one argument, it is subtracted from current_date (at midnight).
+func Age(timestamp builder.Exp, other ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("age", withOptional([]builder.Exp{timestamp}, other))
+}
+
+// --- Constructors
+
+// MakeIntervalArgs are the named arguments of MakeInterval. Arguments that are nil are omitted and default to zero.
+type MakeIntervalArgs struct {
+	Years  builder.Exp
+	Months builder.Exp
+	Weeks  builder.Exp
+	Days   builder.Exp
+	Hours  builder.Exp
+	Mins   builder.Exp
+	Secs   builder.Exp
+}
+
+// MakeInterval builds the make_interval function with named arguments.
+//
+//	make_interval ( [ years int [, months int [, weeks int [, days int [, hours int [, mins int [, secs double precision ]]]]]]] ) → interval
+//
+// Create interval from years, months, weeks, days, hours, minutes and seconds fields.
+//
+// Example:
+//
+//	fn.MakeInterval(fn.MakeIntervalArgs{Days: qrb.Arg(7)})
+//	// make_interval(days => $1)
+func MakeInterval(args MakeIntervalArgs) builder.ExpBase {
+	e := namedArgsFuncExp{name: "make_interval"}
+	e.add("years", args.Years)
+	e.add("months", args.Months)
+	e.add("weeks", args.Weeks)
+	e.add("days", args.Days)
+	e.add("hours", args.Hours)
+	e.add("mins", args.Mins)
+	e.add("secs", args.Secs)
+	return builder.ExpBase{Exp: e}
+}
+
+type namedArg struct {
+	name string
+	exp  builder.Exp
+}
+
+// namedArgsFuncExp writes a function call using named notation for the arguments.
+type namedArgsFuncExp struct {
+	name string
+	args []namedArg
+}
+
+func (e *namedArgsFuncExp) add(name string, exp builder.Exp) {
+	if exp != nil {
+		e.args = append(e.args, namedArg{name: name, exp: exp})
+	}
+}
+
+func (e namedArgsFuncExp) IsExp() {}
+
+func (e namedArgsFuncExp) WriteSQL(sb *builder.SQLBuilder) {
+	sb.WriteString(e.name)
+	sb.WriteRune('(')
+	for i, arg := range e.args {
+		if i > 0 {
+			sb.WriteRune(',')
+		}
+		sb.WriteString(arg.name)
+		sb.WriteString(" => ")
+		arg.exp.WriteSQL(sb)
+	}
+	sb.WriteRune(')')
+}
+
+// MakeDate builds the make_date function.
+//
+//	make_date ( year int, month int, day int ) → date
+//
+// Create date from year, month and day fields (negative years signify BC).
+func MakeDate(year, month, day builder.Exp) builder.ExpBase {
+	return builder.FuncExp("make_date", []builder.Exp{year, month, day})
+}
+
+// MakeTime builds the make_time function.
+//
+//	make_time ( hour int, min int, sec double precision ) → time
+//
+// Create time from hour, minute and seconds fields.
+func MakeTime(hour, min, sec builder.Exp) builder.ExpBase {
+	return builder.FuncExp("make_time", []builder.Exp{hour, min, sec})
+}
+
+// MakeTimestamp builds the make_timestamp function.
+//
+//	make_timestamp ( year int, month int, day int, hour int, min int, sec double precision ) → timestamp
+//
+// Create timestamp from year, month, day, hour, minute and seconds fields (negative years signify BC).
+func MakeTimestamp(year, month, day, hour, min, sec builder.Exp) builder.ExpBase {
+	return builder.FuncExp("make_timestamp", []builder.Exp{year, month, day, hour, min, sec})
+}
+
+// MakeTimestamptz builds the make_timestamptz function.
+//
+//	make_timestamptz ( year int, month int, day int, hour int, min int, sec double precision [, timezone text ] ) → timestamp with time zone
+//
+// Create timestamp with time zone from year, month, day, hour, minute and seconds fields (negative years signify BC).
+// If timezone is not specified, the current time zone is used.
+func MakeTimestamptz(year, month, day, hour, min, sec builder.Exp, timeZone ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("make_timestamptz", withOptional([]builder.Exp{year, month, day, hour, min, sec}, timeZone))
+}
+
+// --- Formatting
+
+// ToChar builds the to_char function.
+//
+//	to_char ( timestamp, text ) → text
+//	to_char ( interval, text ) → text
+//	to_char ( numeric_type, text ) → text
+//
+// Converts a value to a string according to the given format.
+func ToChar(value builder.Exp, format builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_char", []builder.Exp{value, format})
+}
+
+// ToDate builds the to_date function.
+//
+//	to_date ( text, text ) → date
+//
+// Converts string to date according to the given format.
+func ToDate(str builder.Exp, format builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_date", []builder.Exp{str, format})
+}
+
+// ToTimestamp builds the to_timestamp function.
+//
+//	to_timestamp ( text, text ) → timestamp with time zone
+//	to_timestamp ( double precision ) → timestamp with time zone
+//
+// Converts string to time stamp according to the given format, or converts a Unix epoch (seconds since 1970-01-01 00:00:00+00) if no format is given.
+func ToTimestamp(value builder.Exp, format ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_timestamp", withOptional([]builder.Exp{value}, format))
+}
+
+// --- Interval normalization
+
+// JustifyDays builds the justify_days function.
+//
+//	justify_days ( interval ) → interval
+//
+// Adjust interval so 30-day time periods are represented as months.
+func JustifyDays(interval builder.Exp) builder.ExpBase {
+	return builder.FuncExp("justify_days", []builder.Exp{interval})
+}
+
+// JustifyHours builds the justify_hours function.
+//
+//	justify_hours ( interval ) → interval
+//
+// Adjust interval so 24-hour time periods are represented as days.
+func JustifyHours(interval builder.Exp) builder.ExpBase {
+	return builder.FuncExp("justify_hours", []builder.Exp{interval})
+}
+
+// JustifyInterval builds the justify_interval function.
+//
+//	justify_interval ( interval ) → interval
+//
+// Adjust interval using justify_days and justify_hours, with additional sign adjustments.
+func JustifyInterval(interval builder.Exp) builder.ExpBase {
+	return builder.FuncExp("justify_interval", []builder.Exp{interval})
+}
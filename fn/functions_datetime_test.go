@@ -2,8 +2,10 @@ package fn_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/builder"
 	"github.com/networkteam/qrb/fn"
 	"github.com/networkteam/qrb/internal/testhelper"
 )
@@ -20,3 +22,54 @@ func TestDatetime(t *testing.T) {
 		)
 	})
 }
+
+func TestDatetimeFunctions(t *testing.T) {
+	t.Run("bucket by time", func(t *testing.T) {
+		q := qrb.Select(
+			fn.DateBin(qrb.IntervalFromDuration(15*time.Minute), qrb.N("created_at"), qrb.String("2000-01-01")),
+		).As("bucket").
+			Select(fn.Count(qrb.N("*"))).
+			From(qrb.N("events")).
+			Where(qrb.N("created_at").Gte(fn.DateTrunc("day", fn.Now(), qrb.String("Europe/Berlin")).Minus(qrb.IntervalFromFields(builder.IntervalFields{Days: 7})))).
+			GroupBy(qrb.Int(1))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT date_bin(INTERVAL '15 minutes', created_at, '2000-01-01') AS bucket, count(*)
+			FROM events
+			WHERE created_at >= date_trunc('day', now(), 'Europe/Berlin') - INTERVAL '7 days'
+			GROUP BY 1`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("current values", func(t *testing.T) {
+		q := qrb.Select(fn.CurrentTimestamp(), fn.CurrentTimestamp(3), fn.CurrentDate(), fn.LocalTime(), fn.ClockTimestamp())
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT CURRENT_TIMESTAMP, CURRENT_TIMESTAMP(3), CURRENT_DATE, LOCALTIME, clock_timestamp()`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("constructors and formatting", func(t *testing.T) {
+		q := qrb.Select(
+			fn.MakeInterval(fn.MakeIntervalArgs{Days: qrb.Arg(7), Hours: qrb.Int(12)}),
+			fn.MakeTimestamptz(qrb.Int(2024), qrb.Int(1), qrb.Int(31), qrb.Int(12), qrb.Int(0), qrb.Float(0.5), qrb.String("UTC")),
+			fn.ToChar(qrb.N("created_at"), qrb.String("YYYY-MM-DD")),
+			fn.ToTimestamp(qrb.N("epoch")),
+			fn.ToTimestamp(qrb.N("raw"), qrb.String("DD.MM.YYYY")),
+			fn.Age(qrb.N("birthday")),
+			fn.JustifyInterval(fn.Age(qrb.N("ends_at"), qrb.N("starts_at"))),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT make_interval(days => $1, hours => 12), make_timestamptz(2024, 1, 31, 12, 0, 0.5, 'UTC'),
+			to_char(created_at, 'YYYY-MM-DD'), to_timestamp(epoch), to_timestamp(raw, 'DD.MM.YYYY'),
+			age(birthday), justify_interval(age(ends_at, starts_at))`,
+			[]any{7},
+			q,
+		)
+	})
+}
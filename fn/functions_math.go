@@ -0,0 +1,305 @@
+package fn
+
+import "github.com/networkteam/qrb/builder"
+
+// See https://www.postgresql.org/docs/current/functions-math.html
+//
+// Mathematical operators are available on builder.ExpBase (e.g. ExpBase.Plus, ExpBase.BitAnd),
+// the prefix operators |/, ||/, @ and ~ are available as builder.SquareRoot, builder.CubeRoot, builder.AbsoluteValue and builder.BitNot.
+
+// Abs builds the abs function.
+//
+//	abs ( numeric_type ) → numeric_type
+//
+// Absolute value.
+func Abs(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("abs", []builder.Exp{x})
+}
+
+// Cbrt builds the cbrt function.
+//
+//	cbrt ( double precision ) → double precision
+//
+// Cube root.
+func Cbrt(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("cbrt", []builder.Exp{x})
+}
+
+// Ceil builds the ceil function.
+//
+//	ceil ( numeric ) → numeric
+//	ceil ( double precision ) → double precision
+//
+// Nearest integer greater than or equal to argument.
+func Ceil(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("ceil", []builder.Exp{x})
+}
+
+// Degrees builds the degrees function.
+//
+//	degrees ( double precision ) → double precision
+//
+// Converts radians to degrees.
+func Degrees(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("degrees", []builder.Exp{x})
+}
+
+// Div builds the div function.
+//
+//	div ( y numeric, x numeric ) → numeric
+//
+// Integer quotient of y/x (truncates towards zero).
+func Div(y, x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("div", []builder.Exp{y, x})
+}
+
+// Exp builds the exp function.
+//
+//	exp ( numeric ) → numeric
+//	exp ( double precision ) → double precision
+//
+// Exponential (e raised to the given power).
+func Exp(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("exp", []builder.Exp{x})
+}
+
+// Factorial builds the factorial function.
+//
+//	factorial ( bigint ) → numeric
+//
+// Factorial.
+func Factorial(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("factorial", []builder.Exp{x})
+}
+
+// Floor builds the floor function.
+//
+//	floor ( numeric ) → numeric
+//	floor ( double precision ) → double precision
+//
+// Nearest integer less than or equal to argument.
+func Floor(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("floor", []builder.Exp{x})
+}
+
+// Gcd builds the gcd function.
+//
+//	gcd ( numeric_type, numeric_type ) → numeric_type
+//
+// Greatest common divisor (the largest positive number that divides both inputs with no remainder); returns 0 if both inputs are zero.
+func Gcd(a, b builder.Exp) builder.ExpBase {
+	return builder.FuncExp("gcd", []builder.Exp{a, b})
+}
+
+// Lcm builds the lcm function.
+//
+//	lcm ( numeric_type, numeric_type ) → numeric_type
+//
+// Least common multiple (the smallest strictly positive number that is an integral multiple of both inputs); returns 0 if either input is zero.
+func Lcm(a, b builder.Exp) builder.ExpBase {
+	return builder.FuncExp("lcm", []builder.Exp{a, b})
+}
+
+// Ln builds the ln function.
+//
+//	ln ( numeric ) → numeric
+//	ln ( double precision ) → double precision
+//
+// Natural logarithm.
+func Ln(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("ln", []builder.Exp{x})
+}
+
+// Log builds the log function.
+//
+//	log ( numeric ) → numeric
+//	log ( double precision ) → double precision
+//
+// Base 10 logarithm.
+func Log(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("log", []builder.Exp{x})
+}
+
+// LogBase builds the log function with an explicit base.
+//
+//	log ( b numeric, x numeric ) → numeric
+//
+// Logarithm of x to base b.
+func LogBase(b, x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("log", []builder.Exp{b, x})
+}
+
+// Mod builds the mod function.
+//
+//	mod ( y numeric_type, x numeric_type ) → numeric_type
+//
+// Remainder of y/x.
+func Mod(y, x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("mod", []builder.Exp{y, x})
+}
+
+// Pi builds the pi function.
+//
+//	pi ( ) → double precision
+//
+// Approximate value of π.
+func Pi() builder.ExpBase {
+	return builder.FuncExp("pi", nil)
+}
+
+// Power builds the power function.
+//
+//	power ( a numeric, b numeric ) → numeric
+//	power ( a double precision, b double precision ) → double precision
+//
+// a raised to the power of b.
+func Power(a, b builder.Exp) builder.ExpBase {
+	return builder.FuncExp("power", []builder.Exp{a, b})
+}
+
+// Radians builds the radians function.
+//
+//	radians ( double precision ) → double precision
+//
+// Converts degrees to radians.
+func Radians(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("radians", []builder.Exp{x})
+}
+
+// Round builds the round function.
+//
+//	round ( numeric ) → numeric
+//	round ( double precision ) → double precision
+//	round ( v numeric, s integer ) → numeric
+//
+// Rounds to nearest integer, or to s decimal places if given.
+//
+// Example:
+//
+//	fn.Round(qrb.N("price").Mult(qrb.Float(1.19)), qrb.Int(2))
+//	// round(price * 1.19, 2)
+func Round(v builder.Exp, s ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("round", withOptional([]builder.Exp{v}, s))
+}
+
+// Scale builds the scale function.
+//
+//	scale ( numeric ) → integer
+//
+// Scale of the argument (the number of decimal digits in the fractional part).
+func Scale(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("scale", []builder.Exp{x})
+}
+
+// Sign builds the sign function.
+//
+//	sign ( numeric ) → numeric
+//	sign ( double precision ) → double precision
+//
+// Sign of the argument (-1, 0, or +1).
+func Sign(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("sign", []builder.Exp{x})
+}
+
+// Sqrt builds the sqrt function.
+//
+//	sqrt ( numeric ) → numeric
+//	sqrt ( double precision ) → double precision
+//
+// Square root.
+func Sqrt(x builder.Exp) builder.ExpBase {
+	return builder.FuncExp("sqrt", []builder.Exp{x})
+}
+
+// Trunc builds the trunc function.
+//
+//	trunc ( numeric ) → numeric
+//	trunc ( double precision ) → double precision
+//	trunc ( v numeric, s integer ) → numeric
+//
+// Truncates to integer (towards zero), or to s decimal places if given.
+func Trunc(v builder.Exp, s ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("trunc", withOptional([]builder.Exp{v}, s))
+}
+
+// WidthBucket builds the width_bucket function with equal-width buckets.
+//
+//	width_bucket ( operand numeric, low numeric, high numeric, count integer ) → integer
+//
+// Returns the number of the bucket in which operand falls in a histogram having count equal-width buckets spanning the range low to high.
+// Returns 0 or count+1 for an input outside that range.
+//
+// Example:
+//
+//	fn.WidthBucket(qrb.N("price"), qrb.Int(0), qrb.Int(100), qrb.Int(10))
+//	// width_bucket(price, 0, 100, 10)
+func WidthBucket(operand, low, high, count builder.Exp) builder.ExpBase {
+	return builder.FuncExp("width_bucket", []builder.Exp{operand, low, high, count})
+}
+
+// WidthBucketThresholds builds the width_bucket function with buckets given by an array of lower bounds.
+//
+//	width_bucket ( operand anycompatible, thresholds anycompatiblearray ) → integer
+//
+// Returns the number of the bucket in which operand falls given an array listing the lower bounds of the buckets.
+// Returns 0 for an input less than the first lower bound. The thresholds array must be sorted, smallest first.
+func WidthBucketThresholds(operand, thresholds builder.Exp) builder.ExpBase {
+	return builder.FuncExp("width_bucket", []builder.Exp{operand, thresholds})
+}
+
+// Clamp restricts x to the range from lower to upper by using the greatest and least functions.
+//
+//	greatest(lower, least(x, upper))
+func Clamp(x, lower, upper builder.Exp) builder.ExpBase {
+	return builder.Greatest(lower, builder.Least(x, upper))
+}
+
+// --- Random functions
+
+// Random builds the random function.
+//
+//	random ( ) → double precision
+//
+// Returns a random value in the range 0.0 <= x < 1.0.
+func Random() builder.ExpBase {
+	return builder.FuncExp("random", nil)
+}
+
+// RandomBetween builds the random function with a range.
+//
+//	random ( min integer, max integer ) → integer
+//	random ( min numeric, max numeric ) → numeric
+//
+// Returns a random value in the range min <= x <= max.
+//
+// Note: requires PostgreSQL 17 or later.
+func RandomBetween(min, max builder.Exp) builder.ExpBase {
+	return builder.FuncExp("random", []builder.Exp{min, max})
+}
+
+// RandomNormal builds the random_normal function.
+//
+//	random_normal ( ) → double precision
+//
+// Returns a random value from the standard normal distribution (mean 0.0 and stddev 1.0).
+func RandomNormal() builder.ExpBase {
+	return builder.FuncExp("random_normal", nil)
+}
+
+// RandomNormalWith builds the random_normal function with the parameters of the distribution.
+//
+//	random_normal ( mean double precision, stddev double precision ) → double precision
+//
+// Returns a random value from the normal distribution with the given parameters.
+func RandomNormalWith(mean, stddev builder.Exp) builder.ExpBase {
+	return builder.FuncExp("random_normal", []builder.Exp{mean, stddev})
+}
+
+// Setseed builds the setseed function.
+//
+//	setseed ( double precision ) → void
+//
+// Sets the seed for subsequent random() and random_normal() calls; argument must be between -1.0 and 1.0, inclusive.
+func Setseed(seed builder.Exp) builder.ExpBase {
+	return builder.FuncExp("setseed", []builder.Exp{seed})
+}
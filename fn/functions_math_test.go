@@ -0,0 +1,72 @@
+package fn_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/fn"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func TestMathFunctions(t *testing.T) {
+	t.Run("pricing", func(t *testing.T) {
+		q := qrb.Select(
+			fn.Round(qrb.N("price").Mult(qrb.Float(1.19)), qrb.Int(2)),
+			fn.Ceil(qrb.N("price")),
+			fn.Floor(qrb.N("price")),
+			fn.Trunc(qrb.N("price")),
+			fn.Abs(qrb.N("discount")),
+			fn.Div(qrb.N("cents"), qrb.Int(100)),
+			fn.Mod(qrb.N("cents"), qrb.Int(100)),
+			fn.Clamp(qrb.N("qty"), qrb.Int(1), qrb.Int(10)),
+		).From(qrb.N("products"))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT round(price * 1.19, 2), ceil(price), floor(price), trunc(price), abs(discount), div(cents, 100), mod(cents, 100), GREATEST(1, LEAST(qty, 10)) FROM products`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("histogram", func(t *testing.T) {
+		q := qrb.Select(fn.WidthBucket(qrb.N("price"), qrb.Int(0), qrb.Int(100), qrb.Int(10))).As("bucket").
+			Select(fn.Count(qrb.N("*"))).
+			From(qrb.N("products")).
+			GroupBy(qrb.Int(1))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT width_bucket(price, 0, 100, 10) AS bucket, count(*) FROM products GROUP BY 1`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("logarithms and powers", func(t *testing.T) {
+		q := qrb.Select(
+			fn.Power(qrb.N("x"), qrb.Int(2)),
+			fn.Ln(qrb.N("x")),
+			fn.Log(qrb.N("x")),
+			fn.LogBase(qrb.Int(2), qrb.N("x")),
+			fn.Factorial(qrb.Int(5)),
+			fn.Random(),
+			fn.RandomBetween(qrb.Int(1), qrb.Int(6)),
+			fn.RandomNormal(),
+			fn.RandomNormalWith(qrb.Float(10), qrb.Float(2.5)),
+			fn.Setseed(qrb.Float(0.5)),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT power(x, 2), ln(x), log(x), log(2, x), factorial(5), random(), random(1, 6), random_normal(), random_normal(10, 2.5), setseed(0.5)`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("argument count", func(t *testing.T) {
+		require.Panics(t, func() {
+			fn.Round(qrb.N("x"), qrb.Int(1), qrb.Int(2))
+		})
+	})
+}
@@ -1,6 +1,20 @@
 package fn
 
-import "github.com/networkteam/qrb/builder"
+import (
+	"errors"
+
+	"github.com/networkteam/qrb/builder"
+)
+
+// See https://www.postgresql.org/docs/current/functions-string.html
+
+// withOptional appends at most one optional argument to the arguments.
+func withOptional(args []builder.Exp, optional []builder.Exp) []builder.Exp {
+	if len(optional) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	return append(args, optional...)
+}
 
 func Lower(identer builder.Exp) builder.ExpBase {
 	return builder.FuncExp("lower", []builder.Exp{identer})
@@ -13,3 +27,730 @@ func Upper(identer builder.Exp) builder.ExpBase {
 func Initcap(identer builder.Exp) builder.ExpBase {
 	return builder.FuncExp("initcap", []builder.Exp{identer})
 }
+
+// BitLength builds the bit_length function.
+//
+//	bit_length ( text ) → integer
+//
+// Returns number of bits in the string (8 times the octet_length).
+func BitLength(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("bit_length", []builder.Exp{str})
+}
+
+// CharLength builds the char_length function.
+//
+//	char_length ( text ) → integer
+//
+// Returns number of characters in the string.
+func CharLength(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("char_length", []builder.Exp{str})
+}
+
+// Length builds the length function.
+//
+//	length ( text ) → integer
+//
+// Returns the number of characters in the string.
+func Length(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("length", []builder.Exp{str})
+}
+
+// Normalize builds the normalize function.
+// If no form is given, PostgreSQL defaults to NFC.
+//
+//	normalize ( text [, form ] ) → text
+//
+// Converts the string to the specified Unicode normalization form.
+// The function can only be used when the server encoding is UTF8.
+func Normalize(str builder.Exp, form ...builder.NormalizationForm) builder.ExpBase {
+	if len(form) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	e := normalizeExp{str: str}
+	if len(form) > 0 {
+		e.form = form[0]
+	}
+	return builder.ExpBase{Exp: e}
+}
+
+type normalizeExp struct {
+	str  builder.Exp
+	form builder.NormalizationForm
+}
+
+func (e normalizeExp) IsExp() {}
+
+func (e normalizeExp) WriteSQL(sb *builder.SQLBuilder) {
+	sb.WriteString("normalize(")
+	e.str.WriteSQL(sb)
+	if e.form != "" {
+		sb.WriteString(", ")
+		sb.WriteString(string(e.form))
+	}
+	sb.WriteRune(')')
+}
+
+// OctetLength builds the octet_length function.
+//
+//	octet_length ( text ) → integer
+//
+// Returns number of bytes in the string.
+func OctetLength(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("octet_length", []builder.Exp{str})
+}
+
+// Overlay builds the overlay(string PLACING newsubstring FROM start [FOR count]) function.
+//
+//	overlay ( string text PLACING newsubstring text FROM start integer [ FOR count integer ] ) → text
+//
+// Replaces the substring of string that starts at the start'th character and extends for count characters with newsubstring.
+// If count is omitted, it defaults to the length of newsubstring.
+func Overlay(str builder.Exp, newSubstring builder.Exp, start builder.Exp, count ...builder.Exp) builder.ExpBase {
+	if len(count) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	e := keywordFuncExp{
+		name: "overlay",
+		args: []keywordArg{
+			{exp: str},
+			{keyword: "PLACING", exp: newSubstring},
+			{keyword: "FROM", exp: start},
+		},
+	}
+	if len(count) > 0 {
+		e.args = append(e.args, keywordArg{keyword: "FOR", exp: count[0]})
+	}
+	return builder.ExpBase{Exp: e}
+}
+
+// Position builds the position(substring IN string) function.
+//
+//	position ( substring text IN string text ) → integer
+//
+// Returns first starting index of the specified substring within string, or zero if it's not present.
+func Position(substring builder.Exp, str builder.Exp) builder.ExpBase {
+	return builder.ExpBase{
+		Exp: keywordFuncExp{
+			name: "position",
+			args: []keywordArg{
+				{exp: substring},
+				{keyword: "IN", exp: str},
+			},
+		},
+	}
+}
+
+// Substring builds the substring(string FROM start) function.
+//
+//	substring ( string text [ FROM start integer ] [ FOR count integer ] ) → text
+//
+// Extracts the substring of string starting at the start'th character.
+// Use SubstringFor to also limit the number of characters.
+//
+// Example:
+//
+//	fn.Substring(qrb.N("name"), qrb.Int(2))
+//	// substring(name FROM 2)
+func Substring(str builder.Exp, start builder.Exp) builder.ExpBase {
+	return builder.ExpBase{
+		Exp: keywordFuncExp{
+			name: "substring",
+			args: []keywordArg{
+				{exp: str},
+				{keyword: "FROM", exp: start},
+			},
+		},
+	}
+}
+
+// SubstringFor builds the substring(string FROM start FOR count) function.
+//
+//	substring ( string text [ FROM start integer ] [ FOR count integer ] ) → text
+//
+// Extracts the substring of string starting at the start'th character and stopping after count characters.
+//
+// Example:
+//
+//	fn.SubstringFor(qrb.N("name"), qrb.Int(2), qrb.Int(3))
+//	// substring(name FROM 2 FOR 3)
+func SubstringFor(str builder.Exp, start builder.Exp, count builder.Exp) builder.ExpBase {
+	return builder.ExpBase{
+		Exp: keywordFuncExp{
+			name: "substring",
+			args: []keywordArg{
+				{exp: str},
+				{keyword: "FROM", exp: start},
+				{keyword: "FOR", exp: count},
+			},
+		},
+	}
+}
+
+// SubstringRegexp builds the substring(string FROM pattern) function with a POSIX regular expression.
+//
+//	substring ( string text FROM pattern text ) → text
+//
+// Extracts the first substring matching POSIX regular expression.
+func SubstringRegexp(str builder.Exp, pattern builder.Exp) builder.ExpBase {
+	return builder.ExpBase{
+		Exp: keywordFuncExp{
+			name: "substring",
+			args: []keywordArg{
+				{exp: str},
+				{keyword: "FROM", exp: pattern},
+			},
+		},
+	}
+}
+
+// SubstringSimilar builds the substring(string SIMILAR pattern ESCAPE escape) function.
+//
+//	substring ( string text SIMILAR pattern text ESCAPE escape text ) → text
+//
+// Extracts the first substring matching SQL regular expression.
+func SubstringSimilar(str builder.Exp, pattern builder.Exp, escape builder.Exp) builder.ExpBase {
+	return builder.ExpBase{
+		Exp: keywordFuncExp{
+			name: "substring",
+			args: []keywordArg{
+				{exp: str},
+				{keyword: "SIMILAR", exp: pattern},
+				{keyword: "ESCAPE", exp: escape},
+			},
+		},
+	}
+}
+
+// TrimMode selects the side of a string that is trimmed by Trim.
+type TrimMode string
+
+const (
+	TrimBoth     TrimMode = "BOTH"
+	TrimLeading  TrimMode = "LEADING"
+	TrimTrailing TrimMode = "TRAILING"
+)
+
+// Trim builds the trim([LEADING | TRAILING | BOTH] [characters] FROM string) function.
+// If characters is nil, spaces are removed.
+//
+//	trim ( [ LEADING | TRAILING | BOTH ] [ characters text ] FROM string text ) → text
+//
+// Removes the longest string containing only characters in characters (a space by default) from the start, end, or both ends (BOTH is the default) of string.
+//
+// Example:
+//
+//	fn.Trim(fn.TrimLeading, qrb.String("x"), qrb.N("code"))
+//	// trim(LEADING 'x' FROM code)
+func Trim(mode TrimMode, characters builder.Exp, str builder.Exp) builder.ExpBase {
+	return builder.ExpBase{
+		Exp: trimExp{
+			mode:       mode,
+			characters: characters,
+			str:        str,
+		},
+	}
+}
+
+type trimExp struct {
+	mode       TrimMode
+	characters builder.Exp
+	str        builder.Exp
+}
+
+func (e trimExp) IsExp() {}
+
+func (e trimExp) WriteSQL(sb *builder.SQLBuilder) {
+	sb.WriteString("trim(")
+	if e.mode != "" {
+		sb.WriteString(string(e.mode))
+		sb.WriteRune(' ')
+	}
+	if e.characters != nil {
+		e.characters.WriteSQL(sb)
+		sb.WriteRune(' ')
+	}
+	sb.WriteString("FROM ")
+	e.str.WriteSQL(sb)
+	sb.WriteRune(')')
+}
+
+// UnicodeAssigned builds the unicode_assigned function.
+//
+//	unicode_assigned ( text ) → boolean
+//
+// Returns true if all characters in the string are assigned Unicode codepoints; false otherwise.
+// The function can only be used when the server encoding is UTF8.
+//
+// Note: requires PostgreSQL 17 or later.
+func UnicodeAssigned(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("unicode_assigned", []builder.Exp{str})
+}
+
+// Btrim builds the btrim function.
+//
+//	btrim ( string text [, characters text ] ) → text
+//
+// Removes the longest string containing only characters in characters (a space by default) from the start and end of string.
+func Btrim(str builder.Exp, characters ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("btrim", withOptional([]builder.Exp{str}, characters))
+}
+
+// Ltrim builds the ltrim function.
+//
+//	ltrim ( string text [, characters text ] ) → text
+//
+// Removes the longest string containing only characters in characters (a space by default) from the start of string.
+func Ltrim(str builder.Exp, characters ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("ltrim", withOptional([]builder.Exp{str}, characters))
+}
+
+// Rtrim builds the rtrim function.
+//
+//	rtrim ( string text [, characters text ] ) → text
+//
+// Removes the longest string containing only characters in characters (a space by default) from the end of string.
+func Rtrim(str builder.Exp, characters ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("rtrim", withOptional([]builder.Exp{str}, characters))
+}
+
+// Ascii builds the ascii function.
+//
+//	ascii ( text ) → integer
+//
+// Returns the numeric code of the first character of the argument.
+func Ascii(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("ascii", []builder.Exp{str})
+}
+
+// Casefold builds the casefold function.
+//
+//	casefold ( text ) → text
+//
+// Performs case folding of the input string according to the collation.
+// Case folding is similar to case conversion, but the purpose of case folding is to facilitate case-insensitive matching of strings.
+//
+// Note: requires PostgreSQL 18 or later.
+func Casefold(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("casefold", []builder.Exp{str})
+}
+
+// Chr builds the chr function.
+//
+//	chr ( integer ) → text
+//
+// Returns the character with the given code.
+func Chr(code builder.Exp) builder.ExpBase {
+	return builder.FuncExp("chr", []builder.Exp{code})
+}
+
+// Concat builds the concat function.
+//
+//	concat ( val1 "any" [, val2 "any" [, ...] ] ) → text
+//
+// Concatenates the text representations of all the arguments. NULL arguments are ignored.
+func Concat(val builder.Exp, vals ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("concat", append([]builder.Exp{val}, vals...))
+}
+
+// ConcatWs builds the concat_ws function.
+//
+//	concat_ws ( sep text, val1 "any" [, val2 "any" [, ...] ] ) → text
+//
+// Concatenates all but the first argument, with separators. The first argument is used as the separator string, and should not be NULL.
+// Other NULL arguments are ignored.
+func ConcatWs(sep builder.Exp, val builder.Exp, vals ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("concat_ws", append([]builder.Exp{sep, val}, vals...))
+}
+
+// Format builds the format function.
+//
+//	format ( formatstr text [, formatarg "any" [, ...] ] ) → text
+//
+// Formats arguments according to a format string. This function is similar to the C function sprintf.
+func Format(formatStr builder.Exp, formatArgs ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("format", append([]builder.Exp{formatStr}, formatArgs...))
+}
+
+// Left builds the left function.
+//
+//	left ( string text, n integer ) → text
+//
+// Returns first n characters in the string, or when n is negative, returns all but last |n| characters.
+func Left(str builder.Exp, n builder.Exp) builder.ExpBase {
+	return builder.FuncExp("left", []builder.Exp{str, n})
+}
+
+// Right builds the right function.
+//
+//	right ( string text, n integer ) → text
+//
+// Returns last n characters in the string, or when n is negative, returns all but first |n| characters.
+func Right(str builder.Exp, n builder.Exp) builder.ExpBase {
+	return builder.FuncExp("right", []builder.Exp{str, n})
+}
+
+// Lpad builds the lpad function.
+//
+//	lpad ( string text, length integer [, fill text ] ) → text
+//
+// Extends the string to length length by prepending the characters fill (a space by default).
+// If the string is already longer than length then it is truncated (on the right).
+func Lpad(str builder.Exp, length builder.Exp, fill ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("lpad", withOptional([]builder.Exp{str, length}, fill))
+}
+
+// Rpad builds the rpad function.
+//
+//	rpad ( string text, length integer [, fill text ] ) → text
+//
+// Extends the string to length length by appending the characters fill (a space by default).
+// If the string is already longer than length then it is truncated.
+func Rpad(str builder.Exp, length builder.Exp, fill ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("rpad", withOptional([]builder.Exp{str, length}, fill))
+}
+
+// Md5 builds the md5 function.
+//
+//	md5 ( text ) → text
+//
+// Computes the MD5 hash of the argument, with the result written in hexadecimal.
+func Md5(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("md5", []builder.Exp{str})
+}
+
+// ParseIdent builds the parse_ident function.
+//
+//	parse_ident ( qualified_identifier text [, strict_mode boolean DEFAULT true ] ) → text[]
+//
+// Splits qualified_identifier into an array of identifiers, removing any quoting of individual identifiers.
+func ParseIdent(qualifiedIdentifier builder.Exp, strictMode ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("parse_ident", withOptional([]builder.Exp{qualifiedIdentifier}, strictMode))
+}
+
+// PgClientEncoding builds the pg_client_encoding function.
+//
+//	pg_client_encoding ( ) → name
+//
+// Returns current client encoding name.
+func PgClientEncoding() builder.ExpBase {
+	return builder.FuncExp("pg_client_encoding", nil)
+}
+
+// QuoteIdent builds the quote_ident function.
+//
+//	quote_ident ( text ) → text
+//
+// Returns the given string suitably quoted to be used as an identifier in an SQL statement string.
+func QuoteIdent(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("quote_ident", []builder.Exp{str})
+}
+
+// QuoteLiteral builds the quote_literal function.
+//
+//	quote_literal ( text ) → text
+//
+// Returns the given string suitably quoted to be used as a string literal in an SQL statement string.
+func QuoteLiteral(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("quote_literal", []builder.Exp{str})
+}
+
+// QuoteNullable builds the quote_nullable function.
+//
+//	quote_nullable ( text ) → text
+//
+// Returns the given string suitably quoted to be used as a string literal in an SQL statement string; or, if the argument is null, returns NULL.
+func QuoteNullable(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("quote_nullable", []builder.Exp{str})
+}
+
+// RegexpCount builds the regexp_count function.
+//
+//	regexp_count ( string text, pattern text [, start integer [, flags text ] ] ) → integer
+//
+// Returns the number of times the POSIX regular expression pattern matches in the string.
+func RegexpCount(str builder.Exp, pattern builder.Exp, options ...builder.Exp) builder.ExpBase {
+	if len(options) > 2 {
+		panic(errors.New("too many arguments"))
+	}
+	return builder.FuncExp("regexp_count", append([]builder.Exp{str, pattern}, options...))
+}
+
+// RegexpInstr builds the regexp_instr function.
+//
+//	regexp_instr ( string text, pattern text [, start integer [, N integer [, endoption integer [, flags text [, subexpr integer ] ] ] ] ] ) → integer
+//
+// Returns the position within string where the N'th match of the POSIX regular expression pattern occurs, or zero if there is no such match.
+func RegexpInstr(str builder.Exp, pattern builder.Exp, options ...builder.Exp) builder.ExpBase {
+	if len(options) > 5 {
+		panic(errors.New("too many arguments"))
+	}
+	return builder.FuncExp("regexp_instr", append([]builder.Exp{str, pattern}, options...))
+}
+
+// RegexpLike builds the regexp_like function.
+//
+//	regexp_like ( string text, pattern text [, flags text ] ) → boolean
+//
+// Checks whether a match of the POSIX regular expression pattern occurs within string.
+func RegexpLike(str builder.Exp, pattern builder.Exp, flags ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("regexp_like", withOptional([]builder.Exp{str, pattern}, flags))
+}
+
+// RegexpMatch builds the regexp_match function.
+//
+//	regexp_match ( string text, pattern text [, flags text ] ) → text[]
+//
+// Returns substrings within the first match of the POSIX regular expression pattern to the string.
+func RegexpMatch(str builder.Exp, pattern builder.Exp, flags ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("regexp_match", withOptional([]builder.Exp{str, pattern}, flags))
+}
+
+// RegexpMatches builds the regexp_matches set-returning function.
+//
+//	regexp_matches ( string text, pattern text [, flags text ] ) → setof text[]
+//
+// Returns substrings within the first match of the POSIX regular expression pattern to the string,
+// or substrings within all such matches if the g flag is used.
+func RegexpMatches(str builder.Exp, pattern builder.Exp, flags ...builder.Exp) builder.FuncBuilder {
+	return builder.Func("regexp_matches", withOptional([]builder.Exp{str, pattern}, flags)...)
+}
+
+// RegexpReplace builds the regexp_replace function.
+//
+//	regexp_replace ( string text, pattern text, replacement text [, flags text ] ) → text
+//
+// Replaces the substring that is the first match to the POSIX regular expression pattern,
+// or all such matches if the g flag is used.
+func RegexpReplace(str builder.Exp, pattern builder.Exp, replacement builder.Exp, flags ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("regexp_replace", withOptional([]builder.Exp{str, pattern, replacement}, flags))
+}
+
+// RegexpSplitToArray builds the regexp_split_to_array function.
+//
+//	regexp_split_to_array ( string text, pattern text [, flags text ] ) → text[]
+//
+// Splits string using a POSIX regular expression as the delimiter, producing an array of results.
+func RegexpSplitToArray(str builder.Exp, pattern builder.Exp, flags ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("regexp_split_to_array", withOptional([]builder.Exp{str, pattern}, flags))
+}
+
+// RegexpSplitToTable builds the regexp_split_to_table set-returning function.
+//
+//	regexp_split_to_table ( string text, pattern text [, flags text ] ) → setof text
+//
+// Splits string using a POSIX regular expression as the delimiter, producing a set of results.
+func RegexpSplitToTable(str builder.Exp, pattern builder.Exp, flags ...builder.Exp) builder.FuncBuilder {
+	return builder.Func("regexp_split_to_table", withOptional([]builder.Exp{str, pattern}, flags)...)
+}
+
+// RegexpSubstr builds the regexp_substr function.
+//
+//	regexp_substr ( string text, pattern text [, start integer [, N integer [, flags text [, subexpr integer ] ] ] ] ) → text
+//
+// Returns the substring within string that matches the N'th occurrence of the POSIX regular expression pattern, or NULL if there is no such match.
+func RegexpSubstr(str builder.Exp, pattern builder.Exp, options ...builder.Exp) builder.ExpBase {
+	if len(options) > 4 {
+		panic(errors.New("too many arguments"))
+	}
+	return builder.FuncExp("regexp_substr", append([]builder.Exp{str, pattern}, options...))
+}
+
+// Repeat builds the repeat function.
+//
+//	repeat ( string text, number integer ) → text
+//
+// Repeats string the specified number of times.
+func Repeat(str builder.Exp, number builder.Exp) builder.ExpBase {
+	return builder.FuncExp("repeat", []builder.Exp{str, number})
+}
+
+// Replace builds the replace function.
+//
+//	replace ( string text, from text, to text ) → text
+//
+// Replaces all occurrences in string of substring from with substring to.
+func Replace(str builder.Exp, from builder.Exp, to builder.Exp) builder.ExpBase {
+	return builder.FuncExp("replace", []builder.Exp{str, from, to})
+}
+
+// Reverse builds the reverse function.
+//
+//	reverse ( text ) → text
+//
+// Reverses the order of the characters in the string.
+func Reverse(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("reverse", []builder.Exp{str})
+}
+
+// SplitPart builds the split_part function.
+//
+//	split_part ( string text, delimiter text, n integer ) → text
+//
+// Splits string at occurrences of delimiter and returns the n'th field (counting from one),
+// or when n is negative, returns the |n|'th-from-last field.
+func SplitPart(str builder.Exp, delimiter builder.Exp, n builder.Exp) builder.ExpBase {
+	return builder.FuncExp("split_part", []builder.Exp{str, delimiter, n})
+}
+
+// StartsWith builds the starts_with function.
+//
+//	starts_with ( string text, prefix text ) → boolean
+//
+// Returns true if string starts with prefix.
+func StartsWith(str builder.Exp, prefix builder.Exp) builder.ExpBase {
+	return builder.FuncExp("starts_with", []builder.Exp{str, prefix})
+}
+
+// StringToTable builds the string_to_table set-returning function.
+//
+//	string_to_table ( string text, delimiter text [, null_string text ] ) → setof text
+//
+// Splits the string at occurrences of delimiter and returns the resulting fields as a set of text rows.
+// See StringToArray for splitting into an array.
+func StringToTable(str builder.Exp, delimiter builder.Exp, nullString ...builder.Exp) builder.FuncBuilder {
+	return builder.Func("string_to_table", withOptional([]builder.Exp{str, delimiter}, nullString)...)
+}
+
+// Strpos builds the strpos function.
+//
+//	strpos ( string text, substring text ) → integer
+//
+// Returns first starting index of the specified substring within string, or zero if it's not present. Same as position(substring in string), but note the reversed argument order.
+func Strpos(str builder.Exp, substring builder.Exp) builder.ExpBase {
+	return builder.FuncExp("strpos", []builder.Exp{str, substring})
+}
+
+// Substr builds the substr function.
+//
+//	substr ( string text, start integer [, count integer ] ) → text
+//
+// Extracts the substring of string starting at the start'th character, and extending for count characters if that is specified.
+// This is the same as substring(string from start for count).
+func Substr(str builder.Exp, start builder.Exp, count ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("substr", withOptional([]builder.Exp{str, start}, count))
+}
+
+// ToAscii builds the to_ascii function.
+//
+//	to_ascii ( string text [, encoding name or integer ] ) → text
+//
+// Converts string to ASCII from another encoding, which may be identified by name or number (the database encoding by default).
+func ToAscii(str builder.Exp, encoding ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_ascii", withOptional([]builder.Exp{str}, encoding))
+}
+
+// ToBin builds the to_bin function.
+//
+//	to_bin ( integer ) → text
+//
+// Converts the number to its equivalent two's complement binary representation.
+//
+// Note: requires PostgreSQL 17 or later.
+func ToBin(number builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_bin", []builder.Exp{number})
+}
+
+// ToHex builds the to_hex function.
+//
+//	to_hex ( integer ) → text
+//
+// Converts the number to its equivalent hexadecimal representation.
+func ToHex(number builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_hex", []builder.Exp{number})
+}
+
+// ToOct builds the to_oct function.
+//
+//	to_oct ( integer ) → text
+//
+// Converts the number to its equivalent two's complement octal representation.
+//
+// Note: requires PostgreSQL 17 or later.
+func ToOct(number builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_oct", []builder.Exp{number})
+}
+
+// Translate builds the translate function.
+//
+//	translate ( string text, from text, to text ) → text
+//
+// Replaces each character in string that matches a character in the from set with the corresponding character in the to set.
+// If from is longer than to, occurrences of the extra characters in from are deleted.
+func Translate(str builder.Exp, from builder.Exp, to builder.Exp) builder.ExpBase {
+	return builder.FuncExp("translate", []builder.Exp{str, from, to})
+}
+
+// Unistr builds the unistr function.
+//
+//	unistr ( text ) → text
+//
+// Evaluate escaped Unicode characters in the argument.
+func Unistr(str builder.Exp) builder.ExpBase {
+	return builder.FuncExp("unistr", []builder.Exp{str})
+}
+
+// Sha224 builds the sha224 function.
+//
+//	sha224 ( bytea ) → bytea
+//
+// Computes the SHA-224 hash of the binary string.
+func Sha224(bytes builder.Exp) builder.ExpBase {
+	return builder.FuncExp("sha224", []builder.Exp{bytes})
+}
+
+// Sha256 builds the sha256 function.
+//
+//	sha256 ( bytea ) → bytea
+//
+// Computes the SHA-256 hash of the binary string.
+func Sha256(bytes builder.Exp) builder.ExpBase {
+	return builder.FuncExp("sha256", []builder.Exp{bytes})
+}
+
+// Sha384 builds the sha384 function.
+//
+//	sha384 ( bytea ) → bytea
+//
+// Computes the SHA-384 hash of the binary string.
+func Sha384(bytes builder.Exp) builder.ExpBase {
+	return builder.FuncExp("sha384", []builder.Exp{bytes})
+}
+
+// Sha512 builds the sha512 function.
+//
+//	sha512 ( bytea ) → bytea
+//
+// Computes the SHA-512 hash of the binary string.
+func Sha512(bytes builder.Exp) builder.ExpBase {
+	return builder.FuncExp("sha512", []builder.Exp{bytes})
+}
+
+// keywordArg is an argument of a function in SQL-standard syntax that is preceded by a keyword (e.g. FROM or FOR).
+type keywordArg struct {
+	keyword string
+	exp     builder.Exp
+}
+
+// keywordFuncExp writes a function call in SQL-standard syntax with keywords instead of commas between arguments.
+type keywordFuncExp struct {
+	name string
+	args []keywordArg
+}
+
+func (e keywordFuncExp) IsExp() {}
+
+func (e keywordFuncExp) WriteSQL(sb *builder.SQLBuilder) {
+	sb.WriteString(e.name)
+	sb.WriteRune('(')
+	for i, arg := range e.args {
+		if i > 0 {
+			sb.WriteRune(' ')
+		}
+		if arg.keyword != "" {
+			sb.WriteString(arg.keyword)
+			sb.WriteRune(' ')
+		}
+		arg.exp.WriteSQL(sb)
+	}
+	sb.WriteRune(')')
+}
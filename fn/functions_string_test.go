@@ -3,7 +3,10 @@ package fn_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/builder"
 	"github.com/networkteam/qrb/fn"
 	"github.com/networkteam/qrb/internal/testhelper"
 )
@@ -33,3 +36,108 @@ func TestLetterCases(t *testing.T) {
 		testhelper.AssertSQLEquals(t, `SELECT initcap(a) FROM "table"`, sql)
 	})
 }
+
+func TestStringFunctions(t *testing.T) {
+	t.Run("keyword forms", func(t *testing.T) {
+		q := qrb.Select(
+			fn.SubstringFor(qrb.N("name"), qrb.Int(2), qrb.Int(3)),
+			fn.Substring(qrb.N("name"), qrb.Int(3)),
+			fn.SubstringRegexp(qrb.N("name"), qrb.String("[0-9]+")),
+			fn.SubstringSimilar(qrb.N("name"), qrb.String(`%#"o_b#"%`), qrb.String("#")),
+			fn.Position(qrb.String("@"), qrb.N("email")),
+			fn.Trim(fn.TrimLeading, qrb.String("x"), qrb.N("code")),
+			fn.Trim(fn.TrimBoth, nil, qrb.N("code")),
+			fn.Trim("", nil, qrb.N("code")),
+			fn.Overlay(qrb.N("phone"), qrb.String("***"), qrb.Int(4), qrb.Int(3)),
+			fn.Overlay(qrb.N("phone"), qrb.String("***"), qrb.Int(4)),
+		).From(qrb.N("users"))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT substring(name FROM 2 FOR 3), substring(name FROM 3), substring(name FROM '[0-9]+'), substring(name SIMILAR '%#"o_b#"%' ESCAPE '#'),
+			position('@' IN email), trim(LEADING 'x' FROM code), trim(BOTH FROM code), trim(FROM code),
+			overlay(phone PLACING '***' FROM 4 FOR 3), overlay(phone PLACING '***' FROM 4)
+			FROM users`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("function forms", func(t *testing.T) {
+		q := qrb.Select(
+			fn.Left(qrb.N("name"), qrb.Int(3)),
+			fn.Right(qrb.N("name"), qrb.Int(-2)),
+			fn.Lpad(qrb.N("code"), qrb.Int(5), qrb.String("0")),
+			fn.Rpad(qrb.N("code"), qrb.Int(5)),
+			fn.ConcatWs(qrb.String(", "), qrb.N("last_name"), qrb.N("first_name")),
+			fn.Format(qrb.String("%s-%s"), qrb.N("a"), qrb.N("b")),
+			fn.SplitPart(qrb.N("email"), qrb.String("@"), qrb.Int(2)),
+			fn.Translate(qrb.N("name"), qrb.String("ae"), qrb.String("AE")),
+			fn.Md5(qrb.N("email")),
+		).From(qrb.N("users")).
+			Where(fn.StartsWith(qrb.N("name"), qrb.Arg("Jo")))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT left(name, 3), right(name, -2), lpad(code, 5, '0'), rpad(code, 5), concat_ws(', ', last_name, first_name),
+			format('%s-%s', a, b), split_part(email, '@', 2), translate(name, 'ae', 'AE'), md5(email)
+			FROM users WHERE starts_with(name, $1)`,
+			[]any{"Jo"},
+			q,
+		)
+	})
+
+	t.Run("regular expressions", func(t *testing.T) {
+		q := qrb.Select(
+			fn.RegexpReplace(qrb.N("phone"), qrb.String("[^0-9]"), qrb.String(""), qrb.String("g")),
+			qrb.N("m"),
+		).
+			From(qrb.N("users")).
+			From(fn.RegexpMatches(qrb.N("bio"), qrb.String("#(\\w+)"), qrb.String("g"))).As("m").
+			From(fn.RegexpSplitToTable(qrb.N("tags"), qrb.String("\\s*,\\s*"))).As("t")
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT regexp_replace(phone, '[^0-9]', '', 'g'), m FROM users, regexp_matches(bio, E'#(\\w+)', 'g') AS m, regexp_split_to_table(tags, E'\\s*,\\s*') AS t`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("conversion and splitting", func(t *testing.T) {
+		q := qrb.Select(
+			fn.Normalize(qrb.N("name")),
+			fn.Normalize(qrb.N("name"), builder.NFKC),
+			fn.UnicodeAssigned(qrb.N("name")),
+			fn.Casefold(qrb.N("name")),
+			fn.ParseIdent(qrb.String(`"SomeSchema".someTable`), qrb.Bool(false)),
+			fn.PgClientEncoding(),
+			fn.RegexpInstr(qrb.N("bio"), qrb.String("[0-9]+"), qrb.Int(1), qrb.Int(2)),
+			fn.StringToArray(qrb.N("tags"), qrb.String(","), qrb.String("")),
+			fn.Substr(qrb.N("name"), qrb.Int(2)),
+			fn.ToAscii(qrb.N("name"), qrb.String("LATIN1")),
+			fn.ToBin(qrb.Int(10)),
+			fn.ToOct(qrb.Int(10)),
+			fn.Unistr(qrb.N("escaped")),
+			fn.Sha256(qrb.N("data")),
+			qrb.N("t"),
+		).
+			From(qrb.N("users")).
+			From(fn.StringToTable(qrb.N("tags"), qrb.String(","))).As("t")
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT normalize(name), normalize(name, NFKC), unicode_assigned(name), casefold(name), parse_ident('"SomeSchema".someTable', false),
+			pg_client_encoding(), regexp_instr(bio, '[0-9]+', 1, 2), string_to_array(tags, ',', ''), substr(name, 2),
+			to_ascii(name, 'LATIN1'), to_bin(10), to_oct(10), unistr(escaped), sha256(data), t
+			FROM users, string_to_table(tags, ',') AS t`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("too many arguments", func(t *testing.T) {
+		require.Panics(t, func() {
+			fn.Lpad(qrb.N("code"), qrb.Int(5), qrb.String("0"), qrb.String("1"))
+		})
+		require.Panics(t, func() {
+			fn.Normalize(qrb.N("name"), builder.NFC, builder.NFD)
+		})
+	})
+}
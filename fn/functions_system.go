@@ -0,0 +1,362 @@
+package fn
+
+import (
+	"errors"
+	"hash/fnv"
+
+	"github.com/networkteam/qrb/builder"
+)
+
+// --- Sequence Manipulation Functions
+// See https://www.postgresql.org/docs/current/functions-sequence.html
+
+// Nextval builds the nextval function for the given sequence.
+//
+//	nextval ( regclass ) → bigint
+//
+// Advances the sequence object to its next value and returns that value.
+//
+// Example:
+//
+//	fn.Nextval(qrb.N("order_number_seq"))
+//	// nextval('order_number_seq'::regclass)
+func Nextval(sequence builder.Identer) builder.ExpBase {
+	return builder.FuncExp("nextval", []builder.Exp{builder.Regclass(sequence)})
+}
+
+// Currval builds the currval function for the given sequence.
+//
+//	currval ( regclass ) → bigint
+//
+// Returns the value most recently obtained by nextval for this sequence in the current session.
+func Currval(sequence builder.Identer) builder.ExpBase {
+	return builder.FuncExp("currval", []builder.Exp{builder.Regclass(sequence)})
+}
+
+// Setval builds the setval function for the given sequence.
+//
+//	setval ( regclass, bigint [, boolean ] ) → bigint
+//
+// Sets the sequence object's current value, and optionally its is_called flag.
+func Setval(sequence builder.Identer, value builder.Exp, isCalled ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("setval", withOptional([]builder.Exp{builder.Regclass(sequence), value}, isCalled))
+}
+
+// Lastval builds the lastval function.
+//
+//	lastval () → bigint
+//
+// Returns the value most recently returned by nextval in the current session.
+func Lastval() builder.ExpBase {
+	return builder.FuncExp("lastval", nil)
+}
+
+// --- UUID Functions
+// See https://www.postgresql.org/docs/current/functions-uuid.html
+
+// GenRandomUUID builds the gen_random_uuid function.
+//
+//	gen_random_uuid ( ) → uuid
+//
+// Generates a version 4 (random) UUID.
+func GenRandomUUID() builder.ExpBase {
+	return builder.FuncExp("gen_random_uuid", nil)
+}
+
+// Uuidv7 builds the uuidv7 function (PostgreSQL 18 or later).
+//
+//	uuidv7 ( [ shift interval ] ) → uuid
+//
+// Generates a version 7 (time-ordered) UUID. The timestamp is computed using UNIX timestamp with millisecond precision + sub-millisecond timestamp + random.
+// The optional parameter shift will shift the computed timestamp by the given interval.
+func Uuidv7(shift ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("uuidv7", withOptional(nil, shift))
+}
+
+// UUIDExtractTimestamp builds the uuid_extract_timestamp function.
+//
+//	uuid_extract_timestamp ( uuid ) → timestamp with time zone
+//
+// Extracts a timestamp with time zone from UUID version 1 and 7. For other versions, this function returns null.
+func UUIDExtractTimestamp(uuid builder.Exp) builder.ExpBase {
+	return builder.FuncExp("uuid_extract_timestamp", []builder.Exp{uuid})
+}
+
+// UUIDExtractVersion builds the uuid_extract_version function.
+//
+//	uuid_extract_version ( uuid ) → smallint
+//
+// Extracts the version from a UUID of the variant described by RFC 9562. For other variants, this function returns null.
+func UUIDExtractVersion(uuid builder.Exp) builder.ExpBase {
+	return builder.FuncExp("uuid_extract_version", []builder.Exp{uuid})
+}
+
+// --- System Information Functions
+// See https://www.postgresql.org/docs/current/functions-info.html
+
+// CurrentUser builds the CURRENT_USER value (the user name of the current execution context).
+func CurrentUser() builder.ExpBase {
+	return builder.ExpBase{Exp: sqlValueFuncExp{name: "CURRENT_USER"}}
+}
+
+// CurrentRole builds the CURRENT_ROLE value (equivalent to CURRENT_USER).
+func CurrentRole() builder.ExpBase {
+	return builder.ExpBase{Exp: sqlValueFuncExp{name: "CURRENT_ROLE"}}
+}
+
+// SessionUser builds the SESSION_USER value (the session user's name).
+func SessionUser() builder.ExpBase {
+	return builder.ExpBase{Exp: sqlValueFuncExp{name: "SESSION_USER"}}
+}
+
+// CurrentSchema builds the CURRENT_SCHEMA value (the name of the schema that is first in the search path).
+func CurrentSchema() builder.ExpBase {
+	return builder.ExpBase{Exp: sqlValueFuncExp{name: "CURRENT_SCHEMA"}}
+}
+
+// CurrentDatabase builds the current_database function.
+//
+//	current_database () → name
+//
+// Returns the name of the current database.
+func CurrentDatabase() builder.ExpBase {
+	return builder.FuncExp("current_database", nil)
+}
+
+// PgBackendPid builds the pg_backend_pid function.
+//
+//	pg_backend_pid () → integer
+//
+// Returns the process ID of the server process attached to the current session.
+func PgBackendPid() builder.ExpBase {
+	return builder.FuncExp("pg_backend_pid", nil)
+}
+
+// TxidCurrent builds the txid_current function.
+//
+//	txid_current () → bigint
+//
+// Returns the current transaction's ID. It will assign a new one if the current transaction does not have one already.
+func TxidCurrent() builder.ExpBase {
+	return builder.FuncExp("txid_current", nil)
+}
+
+// PgCurrentXactID builds the pg_current_xact_id function.
+//
+//	pg_current_xact_id () → xid8
+//
+// Returns the current transaction's ID. It will assign a new one if the current transaction does not have one already.
+// This is the preferred replacement for txid_current.
+func PgCurrentXactID() builder.ExpBase {
+	return builder.FuncExp("pg_current_xact_id", nil)
+}
+
+// Version builds the version function.
+//
+//	version () → text
+//
+// Returns a string describing the PostgreSQL server's version.
+func Version() builder.ExpBase {
+	return builder.FuncExp("version", nil)
+}
+
+// --- Configuration Settings Functions
+// See https://www.postgresql.org/docs/current/functions-admin.html#FUNCTIONS-ADMIN-SET
+
+// CurrentSetting builds the current_setting function.
+//
+//	current_setting ( setting_name text [, missing_ok boolean ] ) → text
+//
+// Returns the current value of the setting setting_name. If there is no such setting, current_setting throws an error unless missing_ok is supplied and is true (in which case NULL is returned).
+func CurrentSetting(settingName builder.Exp, missingOk ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("current_setting", withOptional([]builder.Exp{settingName}, missingOk))
+}
+
+// SetConfig builds the set_config function.
+//
+//	set_config ( setting_name text, new_value text, is_local boolean ) → text
+//
+// Sets the parameter setting_name to new_value, and returns that value. If is_local is true, the new value will only apply during the current transaction.
+func SetConfig(settingName builder.Exp, newValue builder.Exp, isLocal builder.Exp) builder.ExpBase {
+	return builder.FuncExp("set_config", []builder.Exp{settingName, newValue, isLocal})
+}
+
+// --- Advisory Lock Functions
+// See https://www.postgresql.org/docs/current/functions-admin.html#FUNCTIONS-ADVISORY-LOCKS
+
+// AdvisoryLockKey is the key of an advisory lock, which is either a single bigint or a pair of integers.
+// Use one of the AdvisoryLockKey constructors, the zero value results in ErrAdvisoryLockKeyEmpty when building the query.
+// Keys derived from strings are hashed with FNV-1a, so the same name always results in the same key across processes and releases.
+type AdvisoryLockKey struct {
+	args []builder.Exp
+}
+
+// AdvisoryLockKeyInt64 builds an advisory lock key from a single bigint.
+func AdvisoryLockKeyInt64(key int64) AdvisoryLockKey {
+	return AdvisoryLockKey{args: []builder.Exp{builder.Arg(key)}}
+}
+
+// AdvisoryLockKeyInt32 builds an advisory lock key from a pair of integers.
+// The keys occupy a different key space than single bigint keys.
+func AdvisoryLockKeyInt32(key1, key2 int32) AdvisoryLockKey {
+	return AdvisoryLockKey{args: []builder.Exp{builder.Arg(key1), builder.Arg(key2)}}
+}
+
+// AdvisoryLockKeyString builds an advisory lock key from a name by hashing it to a bigint (64-bit FNV-1a).
+//
+// Example:
+//
+//	fn.PgTryAdvisoryXactLock(fn.AdvisoryLockKeyString("jobs:cleanup"))
+//	// pg_try_advisory_xact_lock($1)
+func AdvisoryLockKeyString(name string) AdvisoryLockKey {
+	return AdvisoryLockKeyInt64(HashAdvisoryLockKey(name))
+}
+
+// AdvisoryLockKeyStrings builds an advisory lock key from a namespace and a name by hashing each to an integer (32-bit FNV-1a).
+// This allows to use a separate key space for each namespace.
+func AdvisoryLockKeyStrings(namespace, name string) AdvisoryLockKey {
+	return AdvisoryLockKeyInt32(hashAdvisoryLockKey32(namespace), hashAdvisoryLockKey32(name))
+}
+
+// HashAdvisoryLockKey hashes a name to a bigint advisory lock key (64-bit FNV-1a) as used by AdvisoryLockKeyString.
+// It can be used to compare keys with the objid of pg_locks.
+func HashAdvisoryLockKey(name string) int64 {
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return int64(h.Sum64())
+}
+
+func hashAdvisoryLockKey32(name string) int32 {
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	return int32(h.Sum32())
+}
+
+var ErrAdvisoryLockKeyEmpty = errors.New("advisory lock: key is empty")
+
+func advisoryLockFunc(name string, key AdvisoryLockKey) builder.ExpBase {
+	if len(key.args) == 0 {
+		return builder.ExpBase{Exp: errExp{err: ErrAdvisoryLockKeyEmpty}}
+	}
+	return builder.FuncExp(name, key.args)
+}
+
+// errExp reports an error when it is written.
+type errExp struct {
+	err error
+}
+
+func (e errExp) IsExp() {}
+
+func (e errExp) WriteSQL(sb *builder.SQLBuilder) {
+	sb.AddError(e.err)
+}
+
+// PgAdvisoryLock builds the pg_advisory_lock function.
+//
+//	pg_advisory_lock ( key bigint ) → void
+//	pg_advisory_lock ( key1 integer, key2 integer ) → void
+//
+// Obtains an exclusive session-level advisory lock, waiting if necessary.
+func PgAdvisoryLock(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_advisory_lock", key)
+}
+
+// PgAdvisoryLockShared builds the pg_advisory_lock_shared function.
+//
+//	pg_advisory_lock_shared ( key bigint ) → void
+//	pg_advisory_lock_shared ( key1 integer, key2 integer ) → void
+//
+// Obtains a shared session-level advisory lock, waiting if necessary.
+func PgAdvisoryLockShared(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_advisory_lock_shared", key)
+}
+
+// PgAdvisoryUnlock builds the pg_advisory_unlock function.
+//
+//	pg_advisory_unlock ( key bigint ) → boolean
+//	pg_advisory_unlock ( key1 integer, key2 integer ) → boolean
+//
+// Releases a previously-acquired exclusive session-level advisory lock. Returns true if the lock is successfully released.
+func PgAdvisoryUnlock(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_advisory_unlock", key)
+}
+
+// PgAdvisoryUnlockShared builds the pg_advisory_unlock_shared function.
+//
+//	pg_advisory_unlock_shared ( key bigint ) → boolean
+//	pg_advisory_unlock_shared ( key1 integer, key2 integer ) → boolean
+//
+// Releases a previously-acquired shared session-level advisory lock. Returns true if the lock is successfully released.
+func PgAdvisoryUnlockShared(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_advisory_unlock_shared", key)
+}
+
+// PgAdvisoryUnlockAll builds the pg_advisory_unlock_all function.
+//
+//	pg_advisory_unlock_all () → void
+//
+// Releases all session-level advisory locks held by the current session.
+func PgAdvisoryUnlockAll() builder.ExpBase {
+	return builder.FuncExp("pg_advisory_unlock_all", nil)
+}
+
+// PgAdvisoryXactLock builds the pg_advisory_xact_lock function.
+//
+//	pg_advisory_xact_lock ( key bigint ) → void
+//	pg_advisory_xact_lock ( key1 integer, key2 integer ) → void
+//
+// Obtains an exclusive transaction-level advisory lock, waiting if necessary.
+func PgAdvisoryXactLock(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_advisory_xact_lock", key)
+}
+
+// PgAdvisoryXactLockShared builds the pg_advisory_xact_lock_shared function.
+//
+//	pg_advisory_xact_lock_shared ( key bigint ) → void
+//	pg_advisory_xact_lock_shared ( key1 integer, key2 integer ) → void
+//
+// Obtains a shared transaction-level advisory lock, waiting if necessary.
+func PgAdvisoryXactLockShared(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_advisory_xact_lock_shared", key)
+}
+
+// PgTryAdvisoryLock builds the pg_try_advisory_lock function.
+//
+//	pg_try_advisory_lock ( key bigint ) → boolean
+//	pg_try_advisory_lock ( key1 integer, key2 integer ) → boolean
+//
+// Obtains an exclusive session-level advisory lock if available. This will either obtain the lock immediately and return true, or return false without waiting if the lock cannot be acquired immediately.
+func PgTryAdvisoryLock(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_try_advisory_lock", key)
+}
+
+// PgTryAdvisoryLockShared builds the pg_try_advisory_lock_shared function.
+//
+//	pg_try_advisory_lock_shared ( key bigint ) → boolean
+//	pg_try_advisory_lock_shared ( key1 integer, key2 integer ) → boolean
+//
+// Obtains a shared session-level advisory lock if available. This will either obtain the lock immediately and return true, or return false without waiting if the lock cannot be acquired immediately.
+func PgTryAdvisoryLockShared(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_try_advisory_lock_shared", key)
+}
+
+// PgTryAdvisoryXactLock builds the pg_try_advisory_xact_lock function.
+//
+//	pg_try_advisory_xact_lock ( key bigint ) → boolean
+//	pg_try_advisory_xact_lock ( key1 integer, key2 integer ) → boolean
+//
+// Obtains an exclusive transaction-level advisory lock if available. This will either obtain the lock immediately and return true, or return false without waiting if the lock cannot be acquired immediately.
+func PgTryAdvisoryXactLock(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_try_advisory_xact_lock", key)
+}
+
+// PgTryAdvisoryXactLockShared builds the pg_try_advisory_xact_lock_shared function.
+//
+//	pg_try_advisory_xact_lock_shared ( key bigint ) → boolean
+//	pg_try_advisory_xact_lock_shared ( key1 integer, key2 integer ) → boolean
+//
+// Obtains a shared transaction-level advisory lock if available. This will either obtain the lock immediately and return true, or return false without waiting if the lock cannot be acquired immediately.
+func PgTryAdvisoryXactLockShared(key AdvisoryLockKey) builder.ExpBase {
+	return advisoryLockFunc("pg_try_advisory_xact_lock_shared", key)
+}
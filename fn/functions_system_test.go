@@ -0,0 +1,99 @@
+package fn_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/builder"
+	"github.com/networkteam/qrb/fn"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func TestSystemFunctions(t *testing.T) {
+	t.Run("sequences", func(t *testing.T) {
+		q := qrb.Select(
+			fn.Nextval(qrb.N("public.order_number_seq")),
+			fn.Currval(qrb.N(`"OrderNumbers"`)),
+			fn.Setval(qrb.N("order_number_seq"), qrb.Arg(1000), qrb.Bool(false)),
+			fn.Lastval(),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT nextval('public.order_number_seq'::regclass), currval('"OrderNumbers"'::regclass), setval('order_number_seq'::regclass, $1, false), lastval()`,
+			[]any{1000},
+			q,
+		)
+	})
+
+	t.Run("sequence with invalid identifier", func(t *testing.T) {
+		q := qrb.Select(fn.Nextval(qrb.N("seq'; DROP TABLE users; --")))
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrInvalidIdentifier)
+	})
+
+	t.Run("uuids", func(t *testing.T) {
+		q := qrb.InsertInto(qrb.N("events")).
+			ColumnNames("id", "trace_id").
+			Values(fn.Uuidv7(), fn.GenRandomUUID())
+
+		testhelper.AssertSQLWriterEquals(t,
+			`INSERT INTO events (id, trace_id) VALUES (uuidv7(), gen_random_uuid())`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("system information and settings", func(t *testing.T) {
+		q := qrb.Select(
+			fn.CurrentUser(),
+			fn.SessionUser(),
+			fn.CurrentSetting(qrb.String("app.tenant_id"), qrb.Bool(true)),
+			fn.SetConfig(qrb.String("app.tenant_id"), qrb.Arg("42"), qrb.Bool(true)),
+			fn.TxidCurrent(),
+			fn.PgBackendPid(),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT CURRENT_USER, SESSION_USER, current_setting('app.tenant_id', true), set_config('app.tenant_id', $1, true), txid_current(), pg_backend_pid()`,
+			[]any{"42"},
+			q,
+		)
+	})
+
+	t.Run("advisory locks", func(t *testing.T) {
+		q := qrb.Select(
+			fn.PgTryAdvisoryXactLock(fn.AdvisoryLockKeyString("jobs:cleanup")),
+			fn.PgAdvisoryXactLock(fn.AdvisoryLockKeyInt32(1, 2)),
+			fn.PgAdvisoryUnlock(fn.AdvisoryLockKeyInt64(42)),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT pg_try_advisory_xact_lock($1), pg_advisory_xact_lock($2, $3), pg_advisory_unlock($4)`,
+			[]any{fn.HashAdvisoryLockKey("jobs:cleanup"), int32(1), int32(2), int64(42)},
+			q,
+		)
+	})
+
+	t.Run("advisory lock with empty key", func(t *testing.T) {
+		q := qrb.Select(fn.PgTryAdvisoryLock(fn.AdvisoryLockKey{}))
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, fn.ErrAdvisoryLockKeyEmpty)
+	})
+
+	t.Run("advisory lock key hashing is stable", func(t *testing.T) {
+		// 64-bit FNV-1a of "jobs:cleanup"
+		assert.Equal(t, int64(-7242331356350261613), fn.HashAdvisoryLockKey("jobs:cleanup"))
+		assert.NotEqual(t, fn.HashAdvisoryLockKey("jobs:cleanup"), fn.HashAdvisoryLockKey("jobs:import"))
+
+		_, args, err := qrb.Build(fn.PgAdvisoryLock(fn.AdvisoryLockKeyStrings("jobs", "cleanup"))).ToSQL()
+		require.NoError(t, err)
+		_, argsAgain, _ := qrb.Build(fn.PgAdvisoryLock(fn.AdvisoryLockKeyStrings("jobs", "cleanup"))).ToSQL()
+		assert.Equal(t, args, argsAgain)
+		assert.Len(t, args, 2)
+	})
+}
@@ -0,0 +1,209 @@
+package fn
+
+import (
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/networkteam/qrb/builder"
+)
+
+// See https://www.postgresql.org/docs/current/functions-textsearch.html
+
+// withConfig prepends the optional text search configuration to the arguments.
+func withConfig(config builder.Exp, args ...builder.Exp) []builder.Exp {
+	if config == nil {
+		return args
+	}
+	return append([]builder.Exp{config}, args...)
+}
+
+// ToTsvector builds the to_tsvector function.
+// If config is nil, the default_text_search_config is used.
+//
+//	to_tsvector ( [ config regconfig, ] document text ) → tsvector
+//	to_tsvector ( [ config regconfig, ] document json ) → tsvector
+//	to_tsvector ( [ config regconfig, ] document jsonb ) → tsvector
+//
+// Converts text to a tsvector, normalizing words according to the specified or default configuration.
+func ToTsvector(config builder.Exp, document builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_tsvector", withConfig(config, document))
+}
+
+// ToTsquery builds the to_tsquery function.
+// If config is nil, the default_text_search_config is used.
+//
+//	to_tsquery ( [ config regconfig, ] query text ) → tsquery
+//
+// Converts text to a tsquery, normalizing words according to the specified or default configuration.
+// The words must be combined by valid tsquery operators.
+func ToTsquery(config builder.Exp, query builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_tsquery", withConfig(config, query))
+}
+
+// PlaintoTsquery builds the plainto_tsquery function.
+// If config is nil, the default_text_search_config is used.
+//
+//	plainto_tsquery ( [ config regconfig, ] query text ) → tsquery
+//
+// Converts text to a tsquery, normalizing words according to the specified or default configuration.
+// Any punctuation in the string is ignored (it does not determine query operators).
+// The resulting query matches documents containing all non-stopwords in the text.
+func PlaintoTsquery(config builder.Exp, query builder.Exp) builder.ExpBase {
+	return builder.FuncExp("plainto_tsquery", withConfig(config, query))
+}
+
+// PhrasetoTsquery builds the phraseto_tsquery function.
+// If config is nil, the default_text_search_config is used.
+//
+//	phraseto_tsquery ( [ config regconfig, ] query text ) → tsquery
+//
+// Converts text to a tsquery, normalizing words according to the specified or default configuration.
+// Any punctuation in the string is ignored (it does not determine query operators).
+// The resulting query matches phrases containing all non-stopwords in the text.
+func PhrasetoTsquery(config builder.Exp, query builder.Exp) builder.ExpBase {
+	return builder.FuncExp("phraseto_tsquery", withConfig(config, query))
+}
+
+// WebsearchToTsquery builds the websearch_to_tsquery function.
+// If config is nil, the default_text_search_config is used.
+//
+//	websearch_to_tsquery ( [ config regconfig, ] query text ) → tsquery
+//
+// Converts text to a tsquery, normalizing words according to the specified or default configuration.
+// Quoted word sequences are converted to phrase tests. The word “or” is understood as producing an OR operator,
+// and a dash produces a NOT operator; other punctuation is ignored.
+// This approximates the behavior of some common web search tools.
+func WebsearchToTsquery(config builder.Exp, query builder.Exp) builder.ExpBase {
+	return builder.FuncExp("websearch_to_tsquery", withConfig(config, query))
+}
+
+// TsRank builds the ts_rank function.
+//
+//	ts_rank ( vector tsvector, query tsquery [, normalization integer ] ) → real
+//
+// Computes a score showing how well the vector matches the query.
+func TsRank(vector builder.Exp, query builder.Exp, normalization ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("ts_rank", rankArgs(vector, query, normalization))
+}
+
+// TsRankCd builds the ts_rank_cd function.
+//
+//	ts_rank_cd ( vector tsvector, query tsquery [, normalization integer ] ) → real
+//
+// Computes a score showing how well the vector matches the query, using a cover density algorithm.
+func TsRankCd(vector builder.Exp, query builder.Exp, normalization ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("ts_rank_cd", rankArgs(vector, query, normalization))
+}
+
+func rankArgs(vector builder.Exp, query builder.Exp, normalization []builder.Exp) []builder.Exp {
+	args := []builder.Exp{vector, query}
+	if len(normalization) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	if len(normalization) > 0 {
+		args = append(args, normalization[0])
+	}
+	return args
+}
+
+// TsWeight is a weight label of tsvector lexemes.
+type TsWeight string
+
+const (
+	TsWeightA TsWeight = "A"
+	TsWeightB TsWeight = "B"
+	TsWeightC TsWeight = "C"
+	TsWeightD TsWeight = "D"
+)
+
+// Setweight builds the setweight function.
+//
+//	setweight ( vector tsvector, weight "char" ) → tsvector
+//
+// Assigns the specified weight to each element of the vector.
+//
+// Example:
+//
+//	fn.Setweight(fn.ToTsvector(qrb.String("english"), qrb.N("title")), fn.TsWeightA).
+//		Concat(fn.Setweight(fn.ToTsvector(qrb.String("english"), qrb.N("body")), fn.TsWeightB))
+func Setweight(vector builder.Exp, weight TsWeight) builder.ExpBase {
+	return builder.FuncExp("setweight", []builder.Exp{vector, builder.String(string(weight))})
+}
+
+// TsHeadlineOptions are the options of ts_headline. Zero values are omitted, so the server defaults apply.
+type TsHeadlineOptions struct {
+	// MaxWords is the maximum number of words to display (default 35).
+	MaxWords int
+	// MinWords is the minimum number of words to display (default 15).
+	MinWords int
+	// ShortWord drops words of this length or less at the start and end of a headline (default 3).
+	ShortWord int
+	// HighlightAll uses the whole document as the headline, ignoring the preceding three parameters.
+	HighlightAll bool
+	// MaxFragments is the maximum number of text fragments to display.
+	// The default 0 selects a non-fragment-based headline generation method.
+	MaxFragments int
+	// StartSel is the string to delimit the start of query words appearing in the document (default <b>).
+	StartSel string
+	// StopSel is the string to delimit the end of query words appearing in the document (default </b>).
+	StopSel string
+	// FragmentDelimiter is the string to separate multiple fragments (default " ... ").
+	FragmentDelimiter string
+}
+
+func (o TsHeadlineOptions) String() string {
+	var opts []string
+	addInt := func(name string, value int) {
+		if value != 0 {
+			opts = append(opts, name+"="+strconv.Itoa(value))
+		}
+	}
+	addString := func(name string, value string) {
+		if value == "" {
+			return
+		}
+		// Strings with spaces or commas must be double-quoted.
+		if strings.ContainsAny(value, ` ,"`) {
+			value = `"` + strings.ReplaceAll(value, `"`, `""`) + `"`
+		}
+		opts = append(opts, name+"="+value)
+	}
+
+	addInt("MaxWords", o.MaxWords)
+	addInt("MinWords", o.MinWords)
+	addInt("ShortWord", o.ShortWord)
+	if o.HighlightAll {
+		opts = append(opts, "HighlightAll=true")
+	}
+	addInt("MaxFragments", o.MaxFragments)
+	addString("StartSel", o.StartSel)
+	addString("StopSel", o.StopSel)
+	addString("FragmentDelimiter", o.FragmentDelimiter)
+
+	return strings.Join(opts, ", ")
+}
+
+// TsHeadline builds the ts_headline function.
+// If config is nil, the default_text_search_config is used.
+//
+//	ts_headline ( [ config regconfig, ] document text, query tsquery [, options text ] ) → text
+//
+// Displays, in an abbreviated form, the match(es) for the query in the document, which must be raw text not a tsvector.
+//
+// Example:
+//
+//	fn.TsHeadline(qrb.String("english"), qrb.N("body"), qrb.N("query"), fn.TsHeadlineOptions{MaxFragments: 2, StartSel: "<mark>", StopSel: "</mark>"})
+//	// ts_headline('english', body, query, 'MaxFragments=2, StartSel=<mark>, StopSel=</mark>')
+func TsHeadline(config builder.Exp, document builder.Exp, query builder.Exp, options ...TsHeadlineOptions) builder.ExpBase {
+	args := withConfig(config, document, query)
+	if len(options) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	if len(options) > 0 {
+		if s := options[0].String(); s != "" {
+			args = append(args, builder.String(s))
+		}
+	}
+	return builder.FuncExp("ts_headline", args)
+}
@@ -0,0 +1,109 @@
+package fn_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/fn"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func TestTextSearch(t *testing.T) {
+	t.Run("search with rank", func(t *testing.T) {
+		query := fn.WebsearchToTsquery(qrb.String("english"), qrb.Arg("fat cats"))
+
+		q := qrb.Select(qrb.N("id"), fn.TsRank(qrb.N("search_vector"), query)).As("rank").
+			From(qrb.N("articles")).
+			Where(qrb.N("search_vector").Matches(query)).
+			OrderBy(qrb.N("rank")).Desc()
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT id, ts_rank(search_vector, websearch_to_tsquery('english', $1)) AS rank
+			FROM articles
+			WHERE search_vector @@ websearch_to_tsquery('english', $2)
+			ORDER BY rank DESC`,
+			[]any{"fat cats", "fat cats"},
+			q,
+		)
+	})
+
+	t.Run("to_tsvector without config", func(t *testing.T) {
+		b := fn.ToTsvector(nil, qrb.N("body")).Matches(fn.PlaintoTsquery(nil, qrb.Arg("fat rats")))
+
+		testhelper.AssertSQLWriterEquals(t, `to_tsvector(body) @@ plainto_tsquery($1)`, []any{"fat rats"}, b)
+	})
+
+	t.Run("rank cd with normalization", func(t *testing.T) {
+		b := fn.TsRankCd(qrb.N("v"), fn.PhrasetoTsquery(qrb.String("english"), qrb.String("the cats")), qrb.Int(32))
+
+		testhelper.AssertSQLWriterEquals(t, `ts_rank_cd(v, phraseto_tsquery('english', 'the cats'), 32)`, nil, b)
+	})
+
+	t.Run("setweight", func(t *testing.T) {
+		b := fn.Setweight(fn.ToTsvector(qrb.String("english"), qrb.N("title")), fn.TsWeightA).
+			Concat(fn.Setweight(fn.ToTsvector(qrb.String("english"), qrb.N("body")), fn.TsWeightB))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`setweight(to_tsvector('english', title), 'A') || setweight(to_tsvector('english', body), 'B')`,
+			nil,
+			b,
+		)
+	})
+
+	t.Run("headline with options", func(t *testing.T) {
+		b := fn.TsHeadline(qrb.String("english"), qrb.N("body"), fn.ToTsquery(qrb.String("english"), qrb.Arg("cat")), fn.TsHeadlineOptions{
+			MaxFragments:      2,
+			StartSel:          "<mark>",
+			StopSel:           "</mark>",
+			FragmentDelimiter: " ... ",
+		})
+
+		testhelper.AssertSQLWriterEquals(t,
+			`ts_headline('english', body, to_tsquery('english', $1), 'MaxFragments=2, StartSel=<mark>, StopSel=</mark>, FragmentDelimiter=" ... "')`,
+			[]any{"cat"},
+			b,
+		)
+	})
+
+	t.Run("headline without options", func(t *testing.T) {
+		b := fn.TsHeadline(nil, qrb.N("body"), qrb.N("q"), fn.TsHeadlineOptions{})
+
+		testhelper.AssertSQLWriterEquals(t, `ts_headline(body, q)`, nil, b)
+	})
+
+	t.Run("tsquery combinators", func(t *testing.T) {
+		b := fn.ToTsquery(nil, qrb.String("fat")).
+			TsqueryAnd(fn.ToTsquery(nil, qrb.String("cat")).TsqueryNot()).
+			TsqueryOr(fn.ToTsquery(nil, qrb.String("rat")).FollowedBy(fn.ToTsquery(nil, qrb.String("dog"))))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`to_tsquery('fat') && !! to_tsquery('cat') || (to_tsquery('rat') <-> to_tsquery('dog'))`,
+			nil,
+			b,
+		)
+	})
+
+	t.Run("tsquery not of combination", func(t *testing.T) {
+		b := qrb.N("a").TsqueryOr(qrb.N("b")).TsqueryNot().TsqueryAnd(qrb.N("c"))
+
+		testhelper.AssertSQLWriterEquals(t, `!! (a || b) && c`, nil, b)
+	})
+
+	t.Run("tsquery not of cast", func(t *testing.T) {
+		b := qrb.Arg("cat").Cast("tsquery").TsqueryNot()
+
+		testhelper.AssertSQLWriterEquals(t, `!! ($1::tsquery)`, []any{"cat"}, b)
+	})
+
+	t.Run("tsquery not as right operand", func(t *testing.T) {
+		b := qrb.N("a").TsqueryAnd(qrb.N("b").TsqueryAnd(qrb.N("c")).TsqueryNot())
+
+		testhelper.AssertSQLWriterEquals(t, `a && !! (b && c)`, nil, b)
+	})
+
+	t.Run("followed by distance", func(t *testing.T) {
+		b := qrb.N("a").FollowedByDistance(2, qrb.N("b"))
+
+		testhelper.AssertSQLWriterEquals(t, `a <2> b`, nil, b)
+	})
+}
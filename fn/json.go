@@ -8,40 +8,75 @@ import (
 
 // --- 9.47.JSON Creation Functions
 
-/*
-TODO Implement these JSON creation functions:
-
-to_json ( anyelement ) → json
-
-to_jsonb ( anyelement ) → jsonb
-
-Converts any SQL value to json or jsonb. Arrays and composites are converted recursively to arrays and objects (multidimensional arrays become arrays of arrays in JSON). Otherwise, if there is a cast from the SQL data type to json, the cast function will be used to perform the conversion;[a] otherwise, a scalar JSON value is produced. For any scalar other than a number, a Boolean, or a null value, the text representation will be used, with escaping as necessary to make it a valid JSON string value.
-
-to_json('Fred said "Hi."'::text) → "Fred said \"Hi.\""
-
-to_jsonb(row(42, 'Fred said "Hi."'::text)) → {"f1": 42, "f2": "Fred said \"Hi.\""}
-
-array_to_json ( anyarray [, boolean ] ) → json
-
-Converts an SQL array to a JSON array. The behavior is the same as to_json except that line feeds will be added between top-level array elements if the optional boolean parameter is true.
-
-array_to_json('{{1,5},{99,100}}'::int[]) → [[1,5],[99,100]]
-
-row_to_json ( record [, boolean ] ) → json
-
-Converts an SQL composite value to a JSON object. The behavior is the same as to_json except that line feeds will be added between top-level elements if the optional boolean parameter is true.
-
-row_to_json(row(1,'foo')) → {"f1":1,"f2":"foo"}
+// ToJson builds the to_json function.
+//
+//	to_json ( anyelement ) → json
+//
+// Converts any SQL value to json. Arrays and composites are converted recursively to arrays and objects (multidimensional arrays become arrays of arrays in JSON). Otherwise, if there is a cast from the SQL data type to json, the cast function will be used to perform the conversion; otherwise, a scalar JSON value is produced. For any scalar other than a number, a Boolean, or a null value, the text representation will be used, with escaping as necessary to make it a valid JSON string value.
+func ToJson(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_json", []builder.Exp{exp})
+}
 
-json_build_array ( VARIADIC "any" ) → json
+// ToJsonb builds the to_jsonb function.
+//
+//	to_jsonb ( anyelement ) → jsonb
+//
+// Converts any SQL value to jsonb. See ToJson for details.
+func ToJsonb(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("to_jsonb", []builder.Exp{exp})
+}
 
-jsonb_build_array ( VARIADIC "any" ) → jsonb
+// ArrayToJson builds the array_to_json function.
+//
+//	array_to_json ( anyarray [, boolean ] ) → json
+//
+// Converts an SQL array to a JSON array. The behavior is the same as to_json except that line feeds will be added between top-level array elements if the optional boolean parameter is true.
+func ArrayToJson(arr builder.Exp, pretty ...builder.Exp) builder.ExpBase {
+	args := []builder.Exp{arr}
+	if len(pretty) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	if len(pretty) > 0 {
+		args = append(args, pretty[0])
+	}
+	return builder.FuncExp("array_to_json", args)
+}
 
-Builds a possibly-heterogeneously-typed JSON array out of a variadic argument list. Each argument is converted as per to_json or to_jsonb.
+// RowToJson builds the row_to_json function.
+//
+//	row_to_json ( record [, boolean ] ) → json
+//
+// Converts an SQL composite value to a JSON object. The behavior is the same as to_json except that line feeds will be added between top-level elements if the optional boolean parameter is true.
+func RowToJson(record builder.Exp, pretty ...builder.Exp) builder.ExpBase {
+	args := []builder.Exp{record}
+	if len(pretty) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	if len(pretty) > 0 {
+		args = append(args, pretty[0])
+	}
+	return builder.FuncExp("row_to_json", args)
+}
 
-json_build_array(1, 2, 'foo', 4, 5) → [1, 2, "foo", 4, 5]
+// JsonBuildArray builds the json_build_array function.
+// It is based on a builder pattern to append elements (see builder.JsonBuildArrayBuilder).
+//
+//	( VARIADIC "any" ) → json
+//
+// Builds a possibly-heterogeneously-typed JSON array out of a variadic argument list. Each argument is converted as per to_json.
+func JsonBuildArray(exps ...builder.Exp) builder.JsonBuildArrayBuilder {
+	return builder.JsonBuildArray(false).Append(exps...)
+}
 
-*/
+// JsonbBuildArray builds the jsonb_build_array function.
+// It is based on a builder pattern to append elements (see builder.JsonBuildArrayBuilder).
+//
+//	( VARIADIC "any" ) → jsonb
+//
+// Builds a possibly-heterogeneously-typed JSON array out of a variadic argument list. Each argument is converted as per to_jsonb.
+func JsonbBuildArray(exps ...builder.Exp) builder.JsonBuildArrayBuilder {
+	return builder.JsonBuildArray(true).Append(exps...)
+}
 
 // JsonBuildObject builds the json_build_object function.
 // It is based on a builder pattern to specify properties (see builder.JsonBuildObjectBuilder).
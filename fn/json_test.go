@@ -197,3 +197,38 @@ func TestJsonSetReturningFunctions(t *testing.T) {
 		`, sql)
 	})
 }
+
+func TestJsonCreationFunctions(t *testing.T) {
+	t.Run("to_json and to_jsonb", func(t *testing.T) {
+		q := Select(fn.ToJson(N("name")), fn.ToJsonb(Row(Int(42), N("name"))))
+
+		testhelper.AssertSQLWriterEquals(t, `SELECT to_json(name), to_jsonb((42,name))`, nil, q)
+	})
+
+	t.Run("array_to_json and row_to_json", func(t *testing.T) {
+		q := Select(fn.ArrayToJson(N("tags")), fn.RowToJson(N("u"), Bool(true))).From(N("users")).As("u")
+
+		testhelper.AssertSQLWriterEquals(t, `SELECT array_to_json(tags), row_to_json(u, true) FROM users AS u`, nil, q)
+	})
+
+	t.Run("json_build_array", func(t *testing.T) {
+		q := Select(fn.JsonBuildArray(Int(1), Int(2), String("foo")).Append(Arg(4), N("x")))
+
+		testhelper.AssertSQLWriterEquals(t, `SELECT json_build_array(1, 2, 'foo', $1, x)`, []any{4}, q)
+	})
+
+	t.Run("jsonb_build_array with conditional elements", func(t *testing.T) {
+		includeEmail := false
+		b1 := fn.JsonbBuildArray(N("id"))
+		b2 := b1.
+			AppendIf(includeEmail, N("email")).
+			AppendIf(true, N("name"), fn.JsonbBuildObject().Prop("active", Bool(true)))
+
+		testhelper.AssertSQLWriterEquals(t, `jsonb_build_array(id)`, nil, b1)
+		testhelper.AssertSQLWriterEquals(t, `jsonb_build_array(id, name, jsonb_build_object('active', true))`, nil, b2)
+	})
+
+	t.Run("empty json_build_array", func(t *testing.T) {
+		testhelper.AssertSQLWriterEquals(t, `json_build_array()`, nil, fn.JsonBuildArray())
+	})
+}
@@ -0,0 +1,240 @@
+// Package rangefn provides constructors and functions for PostgreSQL range and multirange types.
+//
+// The functions live in a separate package, since some of them share their names with string functions in fn
+// (e.g. lower and upper). Range operators are available as methods on builder.ExpBase.
+package rangefn
+
+import (
+	"errors"
+
+	"github.com/networkteam/qrb/builder"
+)
+
+// Bounds specifies which bounds of a range are inclusive or exclusive.
+type Bounds string
+
+const (
+	// BoundsClosedOpen includes the lower bound and excludes the upper bound (the default).
+	BoundsClosedOpen Bounds = "[)"
+	// BoundsOpenClosed excludes the lower bound and includes the upper bound.
+	BoundsOpenClosed Bounds = "(]"
+	// BoundsClosed includes both bounds.
+	BoundsClosed Bounds = "[]"
+	// BoundsOpen excludes both bounds.
+	BoundsOpen Bounds = "()"
+)
+
+// --- Range constructors
+
+// See https://www.postgresql.org/docs/current/rangetypes.html#RANGETYPES-CONSTRUCT
+
+func rangeConstructor(name string, lower, upper builder.Exp, bounds []Bounds) builder.ExpBase {
+	args := []builder.Exp{lower, upper}
+	if len(bounds) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	if len(bounds) > 0 {
+		args = append(args, builder.String(string(bounds[0])))
+	}
+	return builder.FuncExp(name, args)
+}
+
+// Int4range builds the int4range constructor function.
+//
+//	int4range ( lower integer, upper integer [, bounds text ] ) → int4range
+//
+// A NULL lower or upper bound makes the range unbounded on that side.
+func Int4range(lower, upper builder.Exp, bounds ...Bounds) builder.ExpBase {
+	return rangeConstructor("int4range", lower, upper, bounds)
+}
+
+// Int8range builds the int8range constructor function.
+//
+//	int8range ( lower bigint, upper bigint [, bounds text ] ) → int8range
+func Int8range(lower, upper builder.Exp, bounds ...Bounds) builder.ExpBase {
+	return rangeConstructor("int8range", lower, upper, bounds)
+}
+
+// Numrange builds the numrange constructor function.
+//
+//	numrange ( lower numeric, upper numeric [, bounds text ] ) → numrange
+func Numrange(lower, upper builder.Exp, bounds ...Bounds) builder.ExpBase {
+	return rangeConstructor("numrange", lower, upper, bounds)
+}
+
+// Tsrange builds the tsrange constructor function.
+//
+//	tsrange ( lower timestamp, upper timestamp [, bounds text ] ) → tsrange
+func Tsrange(lower, upper builder.Exp, bounds ...Bounds) builder.ExpBase {
+	return rangeConstructor("tsrange", lower, upper, bounds)
+}
+
+// Tstzrange builds the tstzrange constructor function.
+//
+//	tstzrange ( lower timestamptz, upper timestamptz [, bounds text ] ) → tstzrange
+//
+// Example:
+//
+//	rangefn.Tstzrange(qrb.Arg(from), qrb.Arg(until), rangefn.BoundsClosedOpen)
+//	// tstzrange($1, $2, '[)')
+func Tstzrange(lower, upper builder.Exp, bounds ...Bounds) builder.ExpBase {
+	return rangeConstructor("tstzrange", lower, upper, bounds)
+}
+
+// Daterange builds the daterange constructor function.
+//
+//	daterange ( lower date, upper date [, bounds text ] ) → daterange
+func Daterange(lower, upper builder.Exp, bounds ...Bounds) builder.ExpBase {
+	return rangeConstructor("daterange", lower, upper, bounds)
+}
+
+// --- Multirange constructors
+
+// Int4multirange builds the int4multirange constructor function.
+//
+//	int4multirange ( VARIADIC int4range[] ) → int4multirange
+//
+// Without arguments, an empty multirange is constructed.
+func Int4multirange(ranges ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("int4multirange", ranges)
+}
+
+// Int8multirange builds the int8multirange constructor function.
+//
+//	int8multirange ( VARIADIC int8range[] ) → int8multirange
+func Int8multirange(ranges ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("int8multirange", ranges)
+}
+
+// Nummultirange builds the nummultirange constructor function.
+//
+//	nummultirange ( VARIADIC numrange[] ) → nummultirange
+func Nummultirange(ranges ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("nummultirange", ranges)
+}
+
+// Tsmultirange builds the tsmultirange constructor function.
+//
+//	tsmultirange ( VARIADIC tsrange[] ) → tsmultirange
+func Tsmultirange(ranges ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("tsmultirange", ranges)
+}
+
+// Tstzmultirange builds the tstzmultirange constructor function.
+//
+//	tstzmultirange ( VARIADIC tstzrange[] ) → tstzmultirange
+func Tstzmultirange(ranges ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("tstzmultirange", ranges)
+}
+
+// Datemultirange builds the datemultirange constructor function.
+//
+//	datemultirange ( VARIADIC daterange[] ) → datemultirange
+func Datemultirange(ranges ...builder.Exp) builder.ExpBase {
+	return builder.FuncExp("datemultirange", ranges)
+}
+
+// --- Range functions
+
+// See https://www.postgresql.org/docs/current/functions-range.html#RANGE-FUNCTIONS-TABLE
+
+// Lower builds the lower function.
+//
+//	lower ( anyrange ) → anyelement
+//	lower ( anymultirange ) → anyelement
+//
+// Extracts the lower bound of the range (NULL if the range is empty or has no lower bound).
+func Lower(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("lower", []builder.Exp{exp})
+}
+
+// Upper builds the upper function.
+//
+//	upper ( anyrange ) → anyelement
+//	upper ( anymultirange ) → anyelement
+//
+// Extracts the upper bound of the range (NULL if the range is empty or has no upper bound).
+func Upper(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("upper", []builder.Exp{exp})
+}
+
+// Isempty builds the isempty function.
+//
+//	isempty ( anyrange ) → boolean
+//	isempty ( anymultirange ) → boolean
+//
+// Is the range empty?
+func Isempty(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("isempty", []builder.Exp{exp})
+}
+
+// LowerInc builds the lower_inc function.
+//
+//	lower_inc ( anyrange ) → boolean
+//	lower_inc ( anymultirange ) → boolean
+//
+// Is the range's lower bound inclusive?
+func LowerInc(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("lower_inc", []builder.Exp{exp})
+}
+
+// UpperInc builds the upper_inc function.
+//
+//	upper_inc ( anyrange ) → boolean
+//	upper_inc ( anymultirange ) → boolean
+//
+// Is the range's upper bound inclusive?
+func UpperInc(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("upper_inc", []builder.Exp{exp})
+}
+
+// LowerInf builds the lower_inf function.
+//
+//	lower_inf ( anyrange ) → boolean
+//	lower_inf ( anymultirange ) → boolean
+//
+// Does the range have no lower bound? (A lower bound of -Infinity returns false.)
+func LowerInf(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("lower_inf", []builder.Exp{exp})
+}
+
+// UpperInf builds the upper_inf function.
+//
+//	upper_inf ( anyrange ) → boolean
+//	upper_inf ( anymultirange ) → boolean
+//
+// Does the range have no upper bound? (An upper bound of Infinity returns false.)
+func UpperInf(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("upper_inf", []builder.Exp{exp})
+}
+
+// RangeMerge builds the range_merge function.
+//
+//	range_merge ( anyrange, anyrange ) → anyrange
+//	range_merge ( anymultirange ) → anyrange
+//
+// Computes the smallest range that includes both of the given ranges, or the entire multirange.
+func RangeMerge(exp builder.Exp, exps ...builder.Exp) builder.ExpBase {
+	if len(exps) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	return builder.FuncExp("range_merge", append([]builder.Exp{exp}, exps...))
+}
+
+// Multirange builds the multirange function.
+//
+//	multirange ( anyrange ) → anymultirange
+//
+// Returns a multirange containing just the given range.
+func Multirange(exp builder.Exp) builder.ExpBase {
+	return builder.FuncExp("multirange", []builder.Exp{exp})
+}
+
+// Unnest builds the unnest function for multiranges.
+//
+//	unnest ( anymultirange ) → setof anyrange
+//
+// Expands a multirange into a set of ranges in ascending order.
+func Unnest(exp builder.Exp) builder.FuncBuilder {
+	return builder.Func("unnest", exp)
+}
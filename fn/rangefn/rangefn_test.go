@@ -0,0 +1,102 @@
+package rangefn_test
+
+import (
+	"testing"
+
+	. "github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/fn/rangefn"
+	"github.com/networkteam/qrb/internal/testhelper"
+)
+
+func TestRangeFunctions(t *testing.T) {
+	t.Run("constructor with bounds", func(t *testing.T) {
+		q := Select(rangefn.Tstzrange(Arg("2024-01-01"), Arg("2024-01-02"), rangefn.BoundsClosedOpen))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT tstzrange($1, $2, '[)')`,
+			[]any{"2024-01-01", "2024-01-02"},
+			q,
+		)
+	})
+
+	t.Run("constructor without bounds", func(t *testing.T) {
+		q := Select(rangefn.Int4range(Int(1), Null()))
+
+		testhelper.AssertSQLWriterEquals(t, `SELECT int4range(1, NULL)`, nil, q)
+	})
+
+	t.Run("multirange constructor", func(t *testing.T) {
+		q := Select(rangefn.Datemultirange(
+			rangefn.Daterange(String("2024-01-01"), String("2024-01-05")),
+			rangefn.Daterange(String("2024-02-01"), String("2024-02-05"), rangefn.BoundsClosed),
+		))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT datemultirange(daterange('2024-01-01', '2024-01-05'), daterange('2024-02-01', '2024-02-05', '[]'))`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("overlapping bookings", func(t *testing.T) {
+		q := Select(N("id")).
+			From(N("bookings")).
+			Where(And(
+				N("room_id").Eq(Arg(7)),
+				N("during").Overlaps(rangefn.Tstzrange(Arg("2024-01-01 10:00"), Arg("2024-01-01 12:00"))),
+				Not(rangefn.Isempty(N("during"))),
+			))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT id FROM bookings WHERE room_id = $1 AND during && tstzrange($2, $3) AND NOT isempty(during)`,
+			[]any{7, "2024-01-01 10:00", "2024-01-01 12:00"},
+			q,
+		)
+	})
+
+	t.Run("bounds", func(t *testing.T) {
+		q := Select(
+			rangefn.Lower(N("during")),
+			rangefn.Upper(N("during")),
+			rangefn.LowerInc(N("during")),
+			rangefn.UpperInf(N("during")),
+		).From(N("bookings"))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT lower(during), upper(during), lower_inc(during), upper_inf(during) FROM bookings`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("range merge", func(t *testing.T) {
+		q := Select(rangefn.RangeMerge(N("a"), N("b")), rangefn.RangeMerge(N("mr")))
+
+		testhelper.AssertSQLWriterEquals(t, `SELECT range_merge(a, b), range_merge(mr)`, nil, q)
+	})
+
+	t.Run("operators", func(t *testing.T) {
+		q := Select(
+			N("a").Contains(N("ts")),
+			N("a").StrictlyLeftOf(N("b")),
+			N("a").StrictlyRightOf(N("b")),
+			N("a").DoesNotExtendRightOf(N("b")),
+			N("a").DoesNotExtendLeftOf(N("b")),
+			N("a").AdjacentTo(N("b")),
+			N("a").Plus(N("b")),
+			N("a").Mult(N("b")),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT a @> ts, a << b, a >> b, a &< b, a &> b, a -|- b, a + b, a * b`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("unnest multirange", func(t *testing.T) {
+		q := Select(N("r")).From(rangefn.Unnest(N("free_slots"))).As("r")
+
+		testhelper.AssertSQLWriterEquals(t, `SELECT r FROM unnest(free_slots) AS r`, nil, q)
+	})
+}
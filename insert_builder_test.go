@@ -385,6 +385,70 @@ WHERE d.zipcode <> '21201'`,
 		)
 	})
 
+	t.Run("returning with old and new on conflict", func(t *testing.T) {
+		q := qrb.
+			InsertInto(qrb.N("counters")).
+			ColumnNames("name", "value").
+			Values(qrb.String("visits"), qrb.Int(1)).
+			OnConflict(qrb.N("name")).DoUpdate().
+			Set("value", qrb.N("counters.value").Plus(qrb.Int(1))).
+			Returning(qrb.Old("value"), qrb.New("value"))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			INSERT INTO counters (name, value) VALUES ('visits', 1)
+			ON CONFLICT (name) DO UPDATE SET value = counters.value + 1
+			RETURNING old.value, new.value
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("overriding system value", func(t *testing.T) {
+		q := qrb.
+			InsertInto(qrb.N("films")).
+			ColumnNames("id", "title").
+			OverridingSystemValue().
+			Values(qrb.Int(42), qrb.String("Bananas"))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			INSERT INTO films (id, title) OVERRIDING SYSTEM VALUE VALUES (42, 'Bananas')
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("overriding user value with query", func(t *testing.T) {
+		q := qrb.
+			InsertInto(qrb.N("films")).
+			OverridingUserValue().
+			Query(qrb.Select(qrb.N("*")).From(qrb.N("tmp_films")))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			INSERT INTO films OVERRIDING USER VALUE SELECT * FROM tmp_films
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("overriding with default values", func(t *testing.T) {
+		q := qrb.
+			InsertInto(qrb.N("films")).
+			OverridingSystemValue().
+			DefaultValues()
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrInsertOverridingWithoutValuesOrQuery)
+	})
+
 	t.Run("use embedded IdentExp", func(t *testing.T) {
 		var films = struct {
 			builder.IdentExp
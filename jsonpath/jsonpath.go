@@ -0,0 +1,507 @@
+// Package jsonpath builds SQL/JSON path expressions for the jsonb_path_* functions.
+//
+// Values are never embedded into the path, but referenced as variables (e.g. $min) which are passed
+// as a separate vars jsonb argument.
+//
+// Example:
+//
+//	p := jsonpath.Root().Key("items").AnyElement().
+//		Filter(jsonpath.Current().Key("price").Gt(jsonpath.Var("min", 10)))
+//	jsonpath.PathQuery(qrb.N("doc"), p)
+//	// jsonb_path_query(doc, '$.items[*] ? (@.price > $min)', $1::jsonb) with $1 = {"min":10}
+package jsonpath
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/networkteam/qrb/builder"
+	"github.com/networkteam/qrb/fn"
+)
+
+// node is a part of a path expression.
+type node interface {
+	write(w *writer)
+}
+
+type writer struct {
+	sb   strings.Builder
+	vars map[string]any
+	err  error
+}
+
+var ErrVariableConflict = errors.New("jsonpath: variable used with different values")
+
+func (w *writer) addVar(name string, value any) {
+	if w.vars == nil {
+		w.vars = make(map[string]any)
+	}
+	if existing, ok := w.vars[name]; ok && !reflect.DeepEqual(existing, value) {
+		w.err = fmt.Errorf("%w: %s", ErrVariableConflict, name)
+		return
+	}
+	w.vars[name] = value
+}
+
+var simpleIdentifierRegex = regexp.MustCompile(`\A[a-zA-Z_][a-zA-Z0-9_]*\z`)
+
+// writeName writes a key or variable name, quoted if necessary.
+func (w *writer) writeName(name string) {
+	if simpleIdentifierRegex.MatchString(name) {
+		w.sb.WriteString(name)
+		return
+	}
+	w.writeString(name)
+}
+
+// writeString writes a string literal.
+func (w *writer) writeString(s string) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) // encoding a string cannot fail
+	w.sb.WriteString(strings.TrimSuffix(buf.String(), "\n"))
+}
+
+type nodeFunc func(w *writer)
+
+func (f nodeFunc) write(w *writer) {
+	f(w)
+}
+
+func text(s string) node {
+	return nodeFunc(func(w *writer) {
+		w.sb.WriteString(s)
+	})
+}
+
+// --- Paths
+
+// Path is a path expression starting at the root item ($) or the current item (@) of a filter.
+type Path struct {
+	start     string
+	accessors []node
+}
+
+// Root starts a path at the root item ($).
+func Root() Path {
+	return Path{start: "$"}
+}
+
+// Current starts a path at the current item (@) inside a filter expression.
+func Current() Path {
+	return Path{start: "@"}
+}
+
+func (p Path) isOperand() {}
+
+func (p Path) append(n node) Path {
+	newPath := p
+	newPath.accessors = make([]node, len(p.accessors), len(p.accessors)+1)
+	copy(newPath.accessors, p.accessors)
+
+	newPath.accessors = append(newPath.accessors, n)
+	return newPath
+}
+
+func (p Path) write(w *writer) {
+	w.sb.WriteString(p.start)
+	for _, n := range p.accessors {
+		n.write(w)
+	}
+}
+
+// Key accesses the member of an object with the given key.
+func (p Path) Key(key string) Path {
+	return p.append(nodeFunc(func(w *writer) {
+		w.sb.WriteRune('.')
+		w.writeName(key)
+	}))
+}
+
+// AnyKey accesses all members of an object (.*).
+func (p Path) AnyKey() Path {
+	return p.append(text(".*"))
+}
+
+// Index accesses the array element at the given index (starting at 0).
+func (p Path) Index(index int) Path {
+	return p.append(text("[" + strconv.Itoa(index) + "]"))
+}
+
+// Slice accesses the array elements from start to end (both inclusive).
+func (p Path) Slice(start, end int) Path {
+	return p.append(text("[" + strconv.Itoa(start) + " to " + strconv.Itoa(end) + "]"))
+}
+
+// AnyElement accesses all array elements ([*]).
+func (p Path) AnyElement() Path {
+	return p.append(text("[*]"))
+}
+
+// Filter selects the items that satisfy the predicate, use Current to refer to the item.
+func (p Path) Filter(predicate Predicate) Path {
+	return p.append(nodeFunc(func(w *writer) {
+		w.sb.WriteString(" ? (")
+		predicate.write(w)
+		w.sb.WriteRune(')')
+	}))
+}
+
+// --- Methods
+
+func (p Path) method(name string) Path {
+	return p.append(text("." + name + "()"))
+}
+
+// Type returns the type of the item (e.g. "number" or "string").
+func (p Path) Type() Path {
+	return p.method("type")
+}
+
+// Size returns the number of elements of an array.
+func (p Path) Size() Path {
+	return p.method("size")
+}
+
+// Double converts a number or string to a floating-point number.
+func (p Path) Double() Path {
+	return p.method("double")
+}
+
+// Ceiling rounds a number up to the nearest integer.
+func (p Path) Ceiling() Path {
+	return p.method("ceiling")
+}
+
+// Floor rounds a number down to the nearest integer.
+func (p Path) Floor() Path {
+	return p.method("floor")
+}
+
+// Abs returns the absolute value of a number.
+func (p Path) Abs() Path {
+	return p.method("abs")
+}
+
+// KeyValue returns the members of an object as objects with key, value and id fields.
+func (p Path) KeyValue() Path {
+	return p.method("keyvalue")
+}
+
+// Datetime converts a string to a date/time value, optionally using a template (e.g. "yyyy-mm-dd").
+func (p Path) Datetime(template ...string) Path {
+	if len(template) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	if len(template) == 0 {
+		return p.method("datetime")
+	}
+	return p.append(nodeFunc(func(w *writer) {
+		w.sb.WriteString(".datetime(")
+		w.writeString(template[0])
+		w.sb.WriteRune(')')
+	}))
+}
+
+// --- Variables
+
+// Operand is a path or variable that can be compared in a predicate.
+type Operand interface {
+	node
+	isOperand()
+}
+
+// Variable references a value that is passed in the vars argument.
+type Variable struct {
+	name  string
+	value any
+}
+
+// Var references a variable with the given value, the value must be serializable to JSON.
+// Using the same name with different values results in ErrVariableConflict.
+func Var(name string, value any) Variable {
+	return Variable{name: name, value: value}
+}
+
+func (v Variable) isOperand() {}
+
+func (v Variable) write(w *writer) {
+	w.addVar(v.name, v.value)
+	w.sb.WriteRune('$')
+	w.writeName(v.name)
+}
+
+// --- Predicates
+
+// Predicate is a boolean expression used in filters or as a path for jsonb_path_match.
+type Predicate struct {
+	n          node
+	precedence int
+}
+
+func (p Predicate) write(w *writer) {
+	p.n.write(w)
+}
+
+// Precedence of predicates to add parentheses as needed.
+const (
+	precedenceOr = iota
+	precedenceAnd
+	precedenceNot
+)
+
+func compare(lft Operand, op string, rgt Operand) Predicate {
+	return Predicate{
+		n: nodeFunc(func(w *writer) {
+			lft.write(w)
+			w.sb.WriteString(" " + op + " ")
+			rgt.write(w)
+		}),
+		precedence: precedenceNot,
+	}
+}
+
+// Eq builds the == comparison.
+func (p Path) Eq(rgt Operand) Predicate {
+	return compare(p, "==", rgt)
+}
+
+// Neq builds the != comparison.
+func (p Path) Neq(rgt Operand) Predicate {
+	return compare(p, "!=", rgt)
+}
+
+// Lt builds the < comparison.
+func (p Path) Lt(rgt Operand) Predicate {
+	return compare(p, "<", rgt)
+}
+
+// Lte builds the <= comparison.
+func (p Path) Lte(rgt Operand) Predicate {
+	return compare(p, "<=", rgt)
+}
+
+// Gt builds the > comparison.
+func (p Path) Gt(rgt Operand) Predicate {
+	return compare(p, ">", rgt)
+}
+
+// Gte builds the >= comparison.
+func (p Path) Gte(rgt Operand) Predicate {
+	return compare(p, ">=", rgt)
+}
+
+// StartsWith tests whether the string starts with the given prefix.
+func (p Path) StartsWith(prefix Operand) Predicate {
+	return compare(p, "starts with", prefix)
+}
+
+// LikeRegex tests whether the string matches the regular expression with optional flags (e.g. "i" for case-insensitive).
+// The pattern must be a literal in SQL/JSON, so it is embedded into the path as an escaped string.
+func (p Path) LikeRegex(pattern string, flags ...string) Predicate {
+	if len(flags) > 1 {
+		panic(errors.New("too many arguments"))
+	}
+	return Predicate{
+		n: nodeFunc(func(w *writer) {
+			p.write(w)
+			w.sb.WriteString(" like_regex ")
+			w.writeString(pattern)
+			if len(flags) > 0 {
+				w.sb.WriteString(" flag ")
+				w.writeString(flags[0])
+			}
+		}),
+		precedence: precedenceNot,
+	}
+}
+
+// Exists tests whether the path returns any item.
+func Exists(p Path) Predicate {
+	return Predicate{
+		n: nodeFunc(func(w *writer) {
+			w.sb.WriteString("exists (")
+			p.write(w)
+			w.sb.WriteRune(')')
+		}),
+		precedence: precedenceNot,
+	}
+}
+
+// IsUnknown tests whether the predicate is unknown.
+func (p Predicate) IsUnknown() Predicate {
+	return Predicate{
+		n: nodeFunc(func(w *writer) {
+			w.sb.WriteRune('(')
+			p.write(w)
+			w.sb.WriteString(") is unknown")
+		}),
+		precedence: precedenceNot,
+	}
+}
+
+// Not negates the predicate.
+func Not(p Predicate) Predicate {
+	return Predicate{
+		n: nodeFunc(func(w *writer) {
+			w.sb.WriteString("!(")
+			p.write(w)
+			w.sb.WriteRune(')')
+		}),
+		precedence: precedenceNot,
+	}
+}
+
+// And combines the predicates with &&.
+func And(predicates ...Predicate) Predicate {
+	return junction("&&", precedenceAnd, predicates)
+}
+
+// Or combines the predicates with ||.
+func Or(predicates ...Predicate) Predicate {
+	return junction("||", precedenceOr, predicates)
+}
+
+func junction(op string, precedence int, predicates []Predicate) Predicate {
+	return Predicate{
+		n: nodeFunc(func(w *writer) {
+			for i, p := range predicates {
+				if i > 0 {
+					w.sb.WriteString(" " + op + " ")
+				}
+				if p.precedence < precedence {
+					w.sb.WriteRune('(')
+					p.write(w)
+					w.sb.WriteRune(')')
+				} else {
+					p.write(w)
+				}
+			}
+		}),
+		precedence: precedence,
+	}
+}
+
+// --- SQL
+
+func render(e node) (string, map[string]any, error) {
+	w := &writer{}
+	e.write(w)
+	return w.sb.String(), w.vars, w.err
+}
+
+// String returns the path expression.
+func (p Path) String() string {
+	s, _, _ := render(p)
+	return s
+}
+
+// String returns the predicate node.
+func (p Predicate) String() string {
+	s, _, _ := render(p)
+	return s
+}
+
+// Literal returns the path expression as an SQL string literal.
+func (p Path) Literal() builder.Exp {
+	return literalExp{e: p}
+}
+
+// Vars returns the variables of the path as an argument of type jsonb.
+func (p Path) Vars() builder.Exp {
+	return varsExp{e: p}
+}
+
+// Literal returns the predicate as an SQL string literal.
+func (p Predicate) Literal() builder.Exp {
+	return literalExp{e: p}
+}
+
+// Vars returns the variables of the predicate as an argument of type jsonb.
+func (p Predicate) Vars() builder.Exp {
+	return varsExp{e: p}
+}
+
+type literalExp struct {
+	e node
+}
+
+func (l literalExp) IsExp() {}
+
+func (l literalExp) WriteSQL(sb *builder.SQLBuilder) {
+	s, _, err := render(l.e)
+	if err != nil {
+		sb.AddError(err)
+		return
+	}
+	builder.String(s).WriteSQL(sb)
+}
+
+type varsExp struct {
+	e node
+}
+
+func (v varsExp) IsExp() {}
+
+func (v varsExp) WriteSQL(sb *builder.SQLBuilder) {
+	_, vars, err := render(v.e)
+	if err != nil {
+		sb.AddError(err)
+		return
+	}
+	if vars == nil {
+		vars = map[string]any{}
+	}
+	data, err := json.Marshal(vars)
+	if err != nil {
+		sb.AddError(fmt.Errorf("jsonpath: encoding vars: %w", err))
+		return
+	}
+	builder.Arg(string(data)).Cast("jsonb").WriteSQL(sb)
+}
+
+// args returns the path and vars arguments, vars are omitted if the node has no variables.
+func args(target builder.Exp, e node) []builder.Exp {
+	_, vars, _ := render(e)
+	if len(vars) == 0 {
+		return []builder.Exp{target, literalExp{e: e}}
+	}
+	return []builder.Exp{target, literalExp{e: e}, varsExp{e: e}}
+}
+
+// PathExists builds the jsonb_path_exists function with the path and its variables.
+func PathExists(target builder.Exp, p Path) builder.ExpBase {
+	a := args(target, p)
+	return fn.JsonbPathExists(a[0], a[1], a[2:]...)
+}
+
+// PathMatch builds the jsonb_path_match function with the predicate and its variables.
+func PathMatch(target builder.Exp, p Predicate) builder.ExpBase {
+	a := args(target, p)
+	return fn.JsonbPathMatch(a[0], a[1], a[2:]...)
+}
+
+// PathQuery builds the jsonb_path_query function with the path and its variables.
+func PathQuery(target builder.Exp, p Path) builder.FuncBuilder {
+	a := args(target, p)
+	return fn.JsonbPathQuery(a[0], a[1], a[2:]...)
+}
+
+// PathQueryArray builds the jsonb_path_query_array function with the path and its variables.
+func PathQueryArray(target builder.Exp, p Path) builder.ExpBase {
+	a := args(target, p)
+	return fn.JsonbPathQueryArray(a[0], a[1], a[2:]...)
+}
+
+// PathQueryFirst builds the jsonb_path_query_first function with the path and its variables.
+func PathQueryFirst(target builder.Exp, p Path) builder.ExpBase {
+	a := args(target, p)
+	return fn.JsonbPathQueryFirst(a[0], a[1], a[2:]...)
+}
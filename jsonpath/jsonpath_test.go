@@ -0,0 +1,134 @@
+package jsonpath_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/internal/testhelper"
+	"github.com/networkteam/qrb/jsonpath"
+)
+
+func TestPath(t *testing.T) {
+	tests := []struct {
+		name     string
+		path     interface{ String() string }
+		expected string
+	}{
+		{
+			name:     "root",
+			path:     jsonpath.Root(),
+			expected: `$`,
+		},
+		{
+			name:     "keys and elements",
+			path:     jsonpath.Root().Key("a").AnyElement().Key("b").Index(0).Slice(1, 3).AnyKey(),
+			expected: `$.a[*].b[0][1 to 3].*`,
+		},
+		{
+			name:     "quoted key",
+			path:     jsonpath.Root().Key("first name").Key(`say "hi"`),
+			expected: `$."first name"."say \"hi\""`,
+		},
+		{
+			name:     "methods",
+			path:     jsonpath.Root().Key("tags").Size(),
+			expected: `$.tags.size()`,
+		},
+		{
+			name:     "datetime with template",
+			path:     jsonpath.Root().Key("date").Datetime("yyyy-mm-dd"),
+			expected: `$.date.datetime("yyyy-mm-dd")`,
+		},
+		{
+			name: "filter",
+			path: jsonpath.Root().Key("items").AnyElement().
+				Filter(jsonpath.Current().Key("price").Gt(jsonpath.Var("min", 10))),
+			expected: `$.items[*] ? (@.price > $min)`,
+		},
+		{
+			name: "filter with junctions",
+			path: jsonpath.Root().Key("items").AnyElement().Filter(jsonpath.And(
+				jsonpath.Or(
+					jsonpath.Current().Key("name").LikeRegex("^foo.*", "i"),
+					jsonpath.Current().Key("name").StartsWith(jsonpath.Var("prefix", "bar")),
+				),
+				jsonpath.Not(jsonpath.Exists(jsonpath.Current().Key("deleted_at"))),
+				jsonpath.Current().Key("tags").Size().Gte(jsonpath.Var("min tags", 1)),
+			)),
+			expected: `$.items[*] ? ((@.name like_regex "^foo.*" flag "i" || @.name starts with $prefix) && !(exists (@.deleted_at)) && @.tags.size() >= $"min tags")`,
+		},
+		{
+			name:     "predicate",
+			path:     jsonpath.Root().Key("a").Eq(jsonpath.Var("a", 1)).IsUnknown(),
+			expected: `($.a == $a) is unknown`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.path.String())
+		})
+	}
+}
+
+func TestPathFunctions(t *testing.T) {
+	t.Run("query with vars", func(t *testing.T) {
+		p := jsonpath.Root().Key("items").AnyElement().
+			Filter(jsonpath.Current().Key("price").Gt(jsonpath.Var("min", 10)))
+
+		q := qrb.Select(qrb.N("item")).From(jsonpath.PathQuery(qrb.N("doc"), p)).As("item")
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT item FROM jsonb_path_query(doc, '$.items[*] ? (@.price > $min)', $1::jsonb) AS item`,
+			[]any{`{"min":10}`},
+			q,
+		)
+	})
+
+	t.Run("exists without vars", func(t *testing.T) {
+		b := jsonpath.PathExists(qrb.N("doc"), jsonpath.Root().Key("tags").AnyElement())
+
+		testhelper.AssertSQLWriterEquals(t, `jsonb_path_exists(doc, '$.tags[*]')`, nil, b)
+	})
+
+	t.Run("user values are not embedded", func(t *testing.T) {
+		p := jsonpath.Root().Key("name").Eq(jsonpath.Var("name", `x" || true || "`))
+
+		b := jsonpath.PathMatch(qrb.N("doc"), p)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`jsonb_path_match(doc, '$.name == $name', $1::jsonb)`,
+			[]any{`{"name":"x\" || true || \""}`},
+			b,
+		)
+	})
+
+	t.Run("query first and array", func(t *testing.T) {
+		p := jsonpath.Root().Key("a")
+
+		q := qrb.Select(jsonpath.PathQueryFirst(qrb.N("doc"), p), jsonpath.PathQueryArray(qrb.N("doc"), p))
+
+		testhelper.AssertSQLWriterEquals(t, `SELECT jsonb_path_query_first(doc, '$.a'), jsonb_path_query_array(doc, '$.a')`, nil, q)
+	})
+
+	t.Run("literal and vars", func(t *testing.T) {
+		p := jsonpath.Root().Key("a").Filter(jsonpath.Current().Lt(jsonpath.Var("max", 5)))
+
+		q := qrb.Select(qrb.JsonQuery(qrb.N("doc"), p.Literal()).WithWrapper(), p.Vars())
+
+		testhelper.AssertSQLWriterEquals(t, `SELECT JSON_QUERY(doc, '$.a ? (@ < $max)' WITH WRAPPER), $1::jsonb`, []any{`{"max":5}`}, q)
+	})
+
+	t.Run("variable conflict", func(t *testing.T) {
+		p := jsonpath.Root().Key("a").Filter(jsonpath.Or(
+			jsonpath.Current().Eq(jsonpath.Var("v", 1)),
+			jsonpath.Current().Eq(jsonpath.Var("v", 2)),
+		))
+
+		_, _, err := qrb.Build(jsonpath.PathExists(qrb.N("doc"), p)).ToSQL()
+		require.ErrorIs(t, err, jsonpath.ErrVariableConflict)
+	})
+}
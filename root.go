@@ -1,7 +1,10 @@
 package qrb
 
 import (
+	"time"
+
 	"github.com/networkteam/qrb/builder"
+	"github.com/networkteam/qrb/types"
 )
 
 // This file exports the root level functions for building queries.
@@ -73,6 +76,11 @@ func Case(exp ...builder.Exp) builder.CaseBuilder {
 	return builder.Case(exp...)
 }
 
+// Cast builds a type cast in the SQL-standard CAST(exp AS type) syntax.
+func Cast(exp builder.Exp, typ types.Type) builder.ExpBase {
+	return builder.Cast(exp, typ)
+}
+
 func Coalesce(exp builder.Exp, rest ...builder.Exp) builder.ExpBase {
 	return builder.Coalesce(exp, rest...)
 }
@@ -142,22 +150,49 @@ func Default() builder.Exp {
 	return builder.Default()
 }
 
-func Interval(s string) builder.Exp {
+func Interval(s string) builder.ExpBase {
 	return builder.Interval(s)
 }
 
+// IntervalFromDuration builds an interval constant from a duration.
+func IntervalFromDuration(d time.Duration) builder.ExpBase {
+	return builder.IntervalFromDuration(d)
+}
+
+// IntervalFromFields builds an interval constant from the given fields.
+func IntervalFromFields(fields builder.IntervalFields) builder.ExpBase {
+	return builder.IntervalFromFields(fields)
+}
+
 // Exps returns a list of expressions.
 // These implement builder.ExpBase, so additional operators can be chained.
 func Exps(exps ...builder.Exp) builder.Expressions {
 	return builder.ToExpressions(exps...)
 }
 
+// Row builds a row constructor for row-wise comparisons, e.g. (a, b) > ($1, $2).
+func Row(exps ...builder.Exp) builder.RowExp {
+	return builder.Row(exps...)
+}
+
 // --- Subquery Expressions
 
 func Exists(subquery builder.SelectExp) builder.Exp {
 	return builder.Exists(subquery)
 }
 
+// InArgs builds exp = ANY ($1) with the values bound as a single array argument.
+// An empty slice results in the constant false.
+func InArgs[T any](exp builder.Exp, values []T) builder.Exp {
+	return builder.InArgs(exp, values)
+}
+
+// NotInArgs builds exp <> ALL ($1) with the values bound as a single array argument.
+// An empty slice results in the constant true.
+func NotInArgs[T any](exp builder.Exp, values []T) builder.Exp {
+	return builder.NotInArgs(exp, values)
+}
+
 // --- Row and Array Comparisons
 
 func Any(exp builder.Exp) builder.Exp {
@@ -168,6 +203,70 @@ func All(exp builder.Exp) builder.Exp {
 	return builder.All(exp)
 }
 
+// --- Returning
+
+// Old references a column of the old row in a RETURNING clause (e.g. Old("*") for old.*).
+func Old(columnName string) builder.IdentExp {
+	return builder.Old(columnName)
+}
+
+// New references a column of the new row in a RETURNING clause (e.g. New("*") for new.*).
+func New(columnName string) builder.IdentExp {
+	return builder.New(columnName)
+}
+
+// --- SQL/JSON
+
+// JsonObject builds a JSON_OBJECT constructor.
+func JsonObject() builder.JsonObjectBuilder {
+	return builder.JsonObject()
+}
+
+// JsonArray builds a JSON_ARRAY constructor of the given values.
+func JsonArray(exps ...builder.Exp) builder.JsonArrayBuilder {
+	return builder.JsonArray(exps...)
+}
+
+// JsonArrayQuery builds a JSON_ARRAY constructor of the rows of a query.
+func JsonArrayQuery(query builder.SelectExp) builder.JsonArrayBuilder {
+	return builder.JsonArrayQuery(query)
+}
+
+// JsonArrayAgg builds the JSON_ARRAYAGG aggregate function.
+func JsonArrayAgg(exp builder.Exp) builder.JsonArrayAggBuilder {
+	return builder.JsonArrayAgg(exp)
+}
+
+// JsonObjectAgg builds the JSON_OBJECTAGG aggregate function.
+func JsonObjectAgg(key, value builder.Exp) builder.JsonObjectAggBuilder {
+	return builder.JsonObjectAgg(key, value)
+}
+
+// JsonExists builds the JSON_EXISTS function.
+func JsonExists(contextItem, path builder.Exp) builder.JsonExistsBuilder {
+	return builder.JsonExists(contextItem, path)
+}
+
+// JsonValue builds the JSON_VALUE function.
+func JsonValue(contextItem, path builder.Exp) builder.JsonValueBuilder {
+	return builder.JsonValue(contextItem, path)
+}
+
+// JsonQuery builds the JSON_QUERY function.
+func JsonQuery(contextItem, path builder.Exp) builder.JsonQueryBuilder {
+	return builder.JsonQuery(contextItem, path)
+}
+
+// JsonTable builds a JSON_TABLE function to be used as a FROM item.
+func JsonTable(contextItem, path builder.Exp) builder.JsonTableBuilder {
+	return builder.JsonTable(contextItem, path)
+}
+
+// JsonProjection starts a projection of the given table into nested jsonb objects.
+func JsonProjection(table builder.Identer) builder.JsonProjectionBuilder {
+	return builder.JsonProjection(table)
+}
+
 // --- Commands
 
 func InsertInto(tableName builder.Identer) builder.InsertBuilder {
@@ -181,3 +280,8 @@ func Update(tableName builder.Identer) builder.UpdateBuilder {
 func DeleteFrom(tableName builder.Identer) builder.DeleteBuilder {
 	return builder.DeleteFrom(tableName)
 }
+
+// SoftDelete starts a new soft-delete policy that rewrites deletes of registered tables into updates of the given column.
+func SoftDelete(columnName string) builder.SoftDeletePolicy {
+	return builder.SoftDelete(columnName)
+}
@@ -877,6 +877,64 @@ func TestSelectBuilder_Where(t *testing.T) {
 		)
 	})
 
+	t.Run("where in empty args", func(t *testing.T) {
+		var ids []int
+
+		q := qrb.Select(qrb.N("username")).
+			From(qrb.N("accounts")).
+			Where(qrb.N("id").In(qrb.Args(ids...))).
+			Where(qrb.N("id").NotIn(qrb.Args(ids...)))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			SELECT username
+			FROM accounts
+			WHERE false AND true
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("where in array arg", func(t *testing.T) {
+		ids := []int{1, 2, 3}
+
+		q := qrb.Select(qrb.N("username")).
+			From(qrb.N("accounts")).
+			Where(qrb.InArgs(qrb.N("id"), ids)).
+			Where(qrb.NotInArgs(qrb.N("role"), []string{"admin", "root"}))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			SELECT username
+			FROM accounts
+			WHERE id = ANY ($1) AND role <> ALL ($2)
+			`,
+			[]any{[]int{1, 2, 3}, []string{"admin", "root"}},
+			q,
+		)
+	})
+
+	t.Run("where in empty array arg", func(t *testing.T) {
+		q := qrb.Select(qrb.N("username")).
+			From(qrb.N("accounts")).
+			Where(qrb.InArgs(qrb.N("id"), []int{})).
+			Where(qrb.NotInArgs(qrb.N("role"), []string(nil)))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			SELECT username
+			FROM accounts
+			WHERE false AND true
+			`,
+			nil,
+			q,
+		)
+	})
+
 	t.Run("where with negated junction", func(t *testing.T) {
 		q := qrb.Select(qrb.N("*")).
 			From(qrb.N("accounts")).
@@ -0,0 +1,153 @@
+// Package types provides names of built-in PostgreSQL data types.
+//
+// The names can be used wherever a type name is expected, e.g. for builder.ExpBase.Cast, builder.Cast,
+// ddl.CreateTable column definitions, builder.FuncBuilder.ColumnDefinition or builder.CreateFunctionBuilder.Param.
+// Using the constants and constructors instead of string literals detects typos at compile time.
+// String literals are still accepted wherever a Type is expected, other strings have to be converted with Type(name).
+//
+// Example:
+//
+//	qrb.Arg(ids).Cast(types.ArrayOf(types.Uuid))
+//	ddl.CreateTable(qrb.N("products")).Column("price", types.Numeric(10, 2))
+package types
+
+import "strconv"
+
+// See https://www.postgresql.org/docs/current/datatype.html
+
+// Type is the name of a PostgreSQL data type.
+type Type string
+
+// --- Numeric Types
+
+const (
+	Smallint Type = "smallint"
+	Integer  Type = "integer"
+	Bigint   Type = "bigint"
+	Int2     Type = "int2"
+	Int4     Type = "int4"
+	Int8     Type = "int8"
+	Real     Type = "real"
+	Float4   Type = "float4"
+	// Float8 is the alias of double precision.
+	Float8 Type = "float8"
+	// NumericAny is numeric without precision and scale, it can store values of any precision.
+	NumericAny  Type = "numeric"
+	Smallserial Type = "smallserial"
+	Serial      Type = "serial"
+	Bigserial   Type = "bigserial"
+	Money       Type = "money"
+)
+
+// Numeric builds the numeric(precision, scale) type.
+// Use NumericAny for numeric without precision and scale.
+func Numeric(precision, scale int) Type {
+	return Type("numeric(" + strconv.Itoa(precision) + "," + strconv.Itoa(scale) + ")")
+}
+
+// --- Character Types
+
+const (
+	Text Type = "text"
+	// VarcharAny is varchar without a length limit.
+	VarcharAny Type = "varchar"
+)
+
+// Varchar builds the varchar(n) type.
+func Varchar(n int) Type {
+	return Type("varchar(" + strconv.Itoa(n) + ")")
+}
+
+// Char builds the char(n) type.
+func Char(n int) Type {
+	return Type("char(" + strconv.Itoa(n) + ")")
+}
+
+// --- Binary, Boolean and Bit String Types
+
+const (
+	Bytea   Type = "bytea"
+	Boolean Type = "boolean"
+)
+
+// Bit builds the bit(n) type.
+func Bit(n int) Type {
+	return Type("bit(" + strconv.Itoa(n) + ")")
+}
+
+// Varbit builds the varbit(n) type (bit varying).
+func Varbit(n int) Type {
+	return Type("varbit(" + strconv.Itoa(n) + ")")
+}
+
+// --- Date/Time Types
+
+const (
+	Date Type = "date"
+	// Time is the time without time zone type.
+	Time Type = "time"
+	// Timetz is the time with time zone type.
+	Timetz Type = "timetz"
+	// Timestamp is the timestamp without time zone type.
+	Timestamp Type = "timestamp"
+	// Timestamptz is the timestamp with time zone type.
+	Timestamptz Type = "timestamptz"
+	Interval    Type = "interval"
+)
+
+// --- JSON, Uuid, Xml and Text Search Types
+
+const (
+	Json     Type = "json"
+	Jsonb    Type = "jsonb"
+	Jsonpath Type = "jsonpath"
+	Uuid     Type = "uuid"
+	Xml      Type = "xml"
+	Tsvector Type = "tsvector"
+	Tsquery  Type = "tsquery"
+)
+
+// --- Network Address Types
+
+const (
+	Inet     Type = "inet"
+	Cidr     Type = "cidr"
+	Macaddr  Type = "macaddr"
+	Macaddr8 Type = "macaddr8"
+)
+
+// --- Range and Multirange Types
+
+const (
+	Int4range      Type = "int4range"
+	Int8range      Type = "int8range"
+	Numrange       Type = "numrange"
+	Tsrange        Type = "tsrange"
+	Tstzrange      Type = "tstzrange"
+	Daterange      Type = "daterange"
+	Int4multirange Type = "int4multirange"
+	Int8multirange Type = "int8multirange"
+	Nummultirange  Type = "nummultirange"
+	Tsmultirange   Type = "tsmultirange"
+	Tstzmultirange Type = "tstzmultirange"
+	Datemultirange Type = "datemultirange"
+)
+
+// --- Object Identifier and Pseudo Types
+
+const (
+	Oid        Type = "oid"
+	Regclass   Type = "regclass"
+	Regproc    Type = "regproc"
+	Regtype    Type = "regtype"
+	Record     Type = "record"
+	Void       Type = "void"
+	Trigger    Type = "trigger"
+	Anyelement Type = "anyelement"
+	Anyarray   Type = "anyarray"
+)
+
+// ArrayOf builds the array type of the given element type (e.g. ArrayOf(Text) is text[]).
+func ArrayOf(elementType Type) Type {
+	return elementType + "[]"
+}
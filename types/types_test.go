@@ -0,0 +1,69 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/networkteam/qrb"
+	"github.com/networkteam/qrb/ddl"
+	"github.com/networkteam/qrb/internal/testhelper"
+	"github.com/networkteam/qrb/types"
+)
+
+func TestTypes(t *testing.T) {
+	t.Run("cast", func(t *testing.T) {
+		q := qrb.Select(
+			qrb.Arg([]string{"a"}).Cast(types.ArrayOf(types.Uuid)),
+			qrb.Arg("1.5").Cast(types.Numeric(10, 2)),
+			qrb.Cast(qrb.Arg("2024-01-01"), types.Timestamptz),
+			qrb.Arg("[2024-01-01,)").Cast(types.Tstzrange),
+		)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT $1::uuid[], $2::numeric(10,2), CAST($3 AS timestamptz), $4::tstzrange`,
+			[]any{[]string{"a"}, "1.5", "2024-01-01", "[2024-01-01,)"},
+			q,
+		)
+	})
+
+	t.Run("create table column", func(t *testing.T) {
+		q := ddl.CreateTable(qrb.N("products")).
+			Column("id", types.Int8).
+			Column("name", types.Varchar(255)).
+			Column("price", types.Numeric(10, 2)).
+			Column("tags", types.ArrayOf(types.Text)).
+			Column("data", types.Jsonb)
+
+		testhelper.AssertSQLWriterEquals(t,
+			`CREATE TABLE products (id int8, name varchar(255), price numeric(10,2), tags text[], data jsonb)`,
+			nil, q,
+		)
+	})
+
+	t.Run("function column definition", func(t *testing.T) {
+		q := qrb.Select(qrb.N("*")).
+			From(qrb.Func("json_to_record", qrb.String(`{"a":1,"b":"x"}`)).
+				ColumnDefinition("a", types.Integer).
+				ColumnDefinition("b", types.Text))
+
+		testhelper.AssertSQLWriterEquals(t,
+			`SELECT * FROM json_to_record('{"a":1,"b":"x"}') AS (a integer, b text)`,
+			nil, q,
+		)
+	})
+
+	t.Run("create function param", func(t *testing.T) {
+		q := ddl.CreateFunction(qrb.N("add")).
+			Param("a", types.Bigint).
+			Param("b", types.Bigint).
+			Returns(types.Bigint).
+			Language("sql").
+			Body("SELECT a + b;")
+
+		testhelper.AssertSQLWriterEquals(t,
+			`CREATE FUNCTION add(a bigint, b bigint) RETURNS bigint LANGUAGE sql AS $$
+				SELECT a + b;
+			$$`,
+			nil, q,
+		)
+	})
+}
@@ -3,6 +3,8 @@ package qrb_test
 import (
 	"testing"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/networkteam/qrb"
 	"github.com/networkteam/qrb/builder"
 	"github.com/networkteam/qrb/internal/testhelper"
@@ -118,6 +120,239 @@ func TestUpdateBuilder(t *testing.T) {
 		)
 	})
 
+	t.Run("only", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("measurements")).
+			Only().
+			Set("archived", qrb.Bool(true)).
+			Where(qrb.N("logdate").Lt(qrb.String("2006-01-01")))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			UPDATE ONLY measurements SET archived = true WHERE logdate < '2006-01-01'
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("with descendants", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("measurements")).
+			WithDescendants().
+			Set("archived", qrb.Bool(true))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			UPDATE measurements * SET archived = true
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("only and with descendants", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("measurements")).
+			Only().
+			WithDescendants().
+			Set("archived", qrb.Bool(true))
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrUpdateOnlyAndDescendants)
+	})
+
+	t.Run("only in with query", func(t *testing.T) {
+		q := qrb.
+			With("archived").As(
+			qrb.Update(qrb.N("measurements")).
+				Only().
+				Set("archived", qrb.Bool(true)).
+				Returning(qrb.N("id")),
+		).
+			Select(qrb.N("id")).From(qrb.N("archived"))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			WITH archived AS (
+				UPDATE ONLY measurements SET archived = true RETURNING id
+			)
+			SELECT id FROM archived
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("returning multiple outputs", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			Set("price", qrb.N("price").Mult(qrb.Float(1.1))).
+			Where(qrb.N("category").Eq(qrb.String("Electronics"))).
+			Returning(qrb.N("id"), qrb.N("price")).As("new_price")
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			UPDATE products SET price = price * 1.1 WHERE category = 'Electronics' RETURNING id, price AS new_price
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("returning old and new", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			Set("price", qrb.N("price").Mult(qrb.Float(1.1))).
+			Returning(qrb.Old("*"), qrb.New("*"))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			UPDATE products SET price = price * 1.1 RETURNING old.*, new.*
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("returning with old and new aliases", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			Set("price", qrb.N("price").Mult(qrb.Float(1.1))).
+			ReturningOldAs("o").
+			ReturningNewAs("n").
+			Returning(qrb.N("o.price")).As("old_price").
+			Returning(qrb.N("n.price")).As("new_price")
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			UPDATE products SET price = price * 1.1
+			RETURNING WITH (OLD AS o, NEW AS n) o.price AS old_price, n.price AS new_price
+			`,
+			nil,
+			q,
+		)
+	})
+
+	t.Run("returning alias without output expressions", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			Set("price", qrb.N("price").Mult(qrb.Float(1.1))).
+			ReturningOldAs("o")
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrReturningAliasWithoutOutputExpressions)
+	})
+
+	t.Run("from rows", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			FromRows(
+				[]builder.ValuesColumn{{Name: "id", Type: "int"}, {Name: "price", Type: "numeric"}, {Name: "name"}},
+				[][]builder.Exp{
+					{qrb.Arg(1), qrb.Arg(9.99), qrb.Arg("Pen")},
+					{qrb.Arg(2), qrb.Arg(19.99), qrb.Arg("Notebook")},
+				},
+			).
+			Key("id").
+			Returning(qrb.N("products.id"))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			UPDATE products SET price = v.price, name = v.name
+			FROM (VALUES ($1::int, $2::numeric, $3), ($4, $5, $6)) AS v (id, price, name)
+			WHERE products.id = v.id
+			RETURNING products.id
+			`,
+			[]any{1, 9.99, "Pen", 2, 19.99, "Notebook"},
+			q,
+		)
+	})
+
+	t.Run("from rows with aliases and composite key", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("stock")).As("s").
+			FromRows(
+				[]builder.ValuesColumn{{Name: "warehouse_id", Type: "int"}, {Name: "article_id", Type: "int"}, {Name: "quantity", Type: "int"}},
+				[][]builder.Exp{
+					{qrb.Int(1), qrb.Int(100), qrb.Arg(5)},
+					{qrb.Int(1), qrb.Int(101), qrb.Arg(0)},
+				},
+			).
+			ValuesAs("new_stock").
+			Key("warehouse_id", "article_id").
+			Where(qrb.N("s.locked").Eq(qrb.Bool(false)))
+
+		testhelper.AssertSQLWriterEquals(
+			t,
+			`
+			UPDATE stock AS s SET quantity = new_stock.quantity
+			FROM (VALUES (1::int, 100::int, $1::int), (1, 101, $2)) AS new_stock (warehouse_id, article_id, quantity)
+			WHERE s.warehouse_id = new_stock.warehouse_id AND s.article_id = new_stock.article_id AND s.locked = false
+			`,
+			[]any{5, 0},
+			q,
+		)
+	})
+
+	t.Run("from rows with unknown key", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			FromRows(
+				[]builder.ValuesColumn{{Name: "id"}, {Name: "price"}},
+				[][]builder.Exp{{qrb.Arg(1), qrb.Arg(9.99)}},
+			).
+			Key("sku")
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrUpdateFromRowsUnknownKey)
+	})
+
+	t.Run("from rows with only key columns", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			FromRows(
+				[]builder.ValuesColumn{{Name: "id"}, {Name: "price"}},
+				[][]builder.Exp{{qrb.Arg(1), qrb.Arg(9.99)}},
+			).
+			Key("id", "price")
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrUpdateNoSetItems)
+	})
+
+	t.Run("from rows without key", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			FromRows(
+				[]builder.ValuesColumn{{Name: "id"}, {Name: "price"}},
+				[][]builder.Exp{{qrb.Arg(1), qrb.Arg(9.99)}},
+			)
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrUpdateNoSetItems)
+	})
+
+	t.Run("from rows with mismatching row", func(t *testing.T) {
+		q := qrb.
+			Update(qrb.N("products")).
+			FromRows(
+				[]builder.ValuesColumn{{Name: "id"}, {Name: "price"}},
+				[][]builder.Exp{{qrb.Arg(1), qrb.Arg(9.99)}, {qrb.Arg(2)}},
+			).
+			Key("id")
+
+		_, _, err := qrb.Build(q).ToSQL()
+		require.ErrorIs(t, err, builder.ErrValuesRowColumnCount)
+	})
+
 	t.Run("set map", func(t *testing.T) {
 		q := qrb.
 			Update(qrb.N("films")).